@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"strings"
@@ -78,7 +79,7 @@ func TestAll_int(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("All() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"strings"
@@ -103,7 +104,7 @@ func TestAnyPred_int(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("AnyPred() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
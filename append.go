@@ -9,7 +9,7 @@ import (
 // [Append]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.append
 func Append[Source any](source iter.Seq[Source], element Source) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("Append", "source", ErrNilSource)
 	}
 	repeat1, _ := Repeat(element, 1)
 	return Concat(source, repeat1)
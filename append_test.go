@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"iter"
 	"testing"
 )
@@ -51,7 +52,7 @@ func TestAppend_int(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("Append() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -2,6 +2,7 @@ package go2linq
 
 import (
 	"iter"
+	"reflect"
 )
 
 // [Cast] casts the elements of a sequence to a specified type.
@@ -9,7 +10,7 @@ import (
 // [Cast]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.cast
 func Cast[Source, Result any](source iter.Seq[Source]) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("Cast", "source", ErrNilSource)
 	}
 	return func(yield func(Result) bool) {
 			for s := range source {
@@ -21,3 +22,29 @@ func Cast[Source, Result any](source iter.Seq[Source]) (iter.Seq[Result], error)
 		},
 		nil
 }
+
+// CastErr casts the elements of a sequence to a specified type.
+// Unlike [Cast], which panics if an element cannot be cast, CastErr yields
+// zero value paired with [InvalidCastError] for such an element and stops.
+func CastErr[Source, Result any](source iter.Seq[Source]) (iter.Seq2[Result, error], error) {
+	if source == nil {
+		return nil, newOperatorError("CastErr", "source", ErrNilSource)
+	}
+	return func(yield func(Result, error) bool) {
+			i := 0
+			for s := range source {
+				var a any = s
+				r, ok := a.(Result)
+				if !ok {
+					var r0 Result
+					yield(r0, &InvalidCastError{Index: i, Type: reflect.TypeOf(a), Target: reflect.TypeFor[Result]()})
+					return
+				}
+				if !yield(r, nil) {
+					return
+				}
+				i++
+			}
+		},
+		nil
+}
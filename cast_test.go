@@ -1,7 +1,10 @@
 package go2linq
 
 import (
+	"errors"
+	"fmt"
 	"iter"
+	"reflect"
 	"testing"
 )
 
@@ -40,7 +43,7 @@ func TestCast_any_int(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("Cast() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -84,3 +87,79 @@ func TestCast_any_string(t *testing.T) {
 		})
 	}
 }
+
+func TestCastErr(t *testing.T) {
+	if _, err := CastErr[any, int](nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("CastErr() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	tests := []struct {
+		name      string
+		source    iter.Seq[any]
+		want      []int
+		wantIndex int
+		wantType  reflect.Type
+	}{
+		{name: "Valid",
+			source:    VarAll[any](1, 2, 3),
+			want:      []int{1, 2, 3},
+			wantIndex: -1,
+		},
+		{name: "InvalidString",
+			source:    VarAll[any](1, 2, "three", 4),
+			want:      []int{1, 2},
+			wantIndex: 2,
+			wantType:  reflect.TypeFor[string](),
+		},
+		{name: "InvalidNil",
+			source:    VarAll[any](nil, 2),
+			want:      nil,
+			wantIndex: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq2, _ := CastErr[any, int](tt.source)
+			var got []int
+			var gotErr error
+			for r, err := range seq2 {
+				if err != nil {
+					gotErr = err
+					continue
+				}
+				got = append(got, r)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CastErr() = %v, want %v", got, tt.want)
+			}
+			if tt.wantIndex < 0 {
+				if gotErr != nil {
+					t.Errorf("CastErr() error = %v", gotErr)
+				}
+				return
+			}
+			var ice *InvalidCastError
+			if !errors.As(gotErr, &ice) || !errors.Is(gotErr, ErrInvalidCast) {
+				t.Fatalf("CastErr() error = %v, want InvalidCastError", gotErr)
+			}
+			if ice.Index != tt.wantIndex || ice.Type != tt.wantType || ice.Target != reflect.TypeFor[int]() {
+				t.Errorf("CastErr() error = %+v", ice)
+			}
+		})
+	}
+}
+
+func ExampleCastErr() {
+	payload := []any{1, 2, "3", 4}
+	ints, _ := CastErr[any, int](SliceAll(payload))
+	for i, err := range ints {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(i)
+	}
+	// Output:
+	// 1
+	// 2
+	// invalid cast: element 2 of type string to int
+}
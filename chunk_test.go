@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"testing"
@@ -67,7 +68,7 @@ func TestChunk_int(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("Chunk() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
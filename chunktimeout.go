@@ -0,0 +1,127 @@
+package go2linq
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// ChunkTimeout splits the elements of a sequence into chunks of size at most 'size'.
+// A chunk is yielded as soon as it contains 'size' elements
+// or 'maxWait' has elapsed since its first element was received, whichever comes first.
+// If 'ctx' is canceled, the pending chunk (if any) is yielded and the sequence ends.
+//
+// 'source' is enumerated in a separate goroutine, so a slow source (e.g. [ChanAll])
+// does not delay partial chunks. When the result's enumeration stops,
+// the goroutine exits after 'source' yields its next element or ends.
+func ChunkTimeout[Source any](ctx context.Context, source iter.Seq[Source], size int, maxWait time.Duration) (iter.Seq[[]Source], error) {
+	if ctx == nil {
+		return nil, newOperatorError("ChunkTimeout", "ctx", ErrNilContext)
+	}
+	if source == nil {
+		return nil, newOperatorError("ChunkTimeout", "source", ErrNilSource)
+	}
+	if size <= 0 {
+		return nil, newOperatorError("ChunkTimeout", "size", ErrSizeOutOfRange)
+	}
+	if maxWait <= 0 {
+		return nil, newOperatorError("ChunkTimeout", "maxWait", ErrNonPositiveWait)
+	}
+	return ChunkTimeoutClock(ctx, source, size, maxWait, SystemClock{})
+}
+
+// ChunkTimeoutClock is like [ChunkTimeout] but uses a specified 'clock' to measure 'maxWait'.
+func ChunkTimeoutClock[Source any](ctx context.Context, source iter.Seq[Source],
+	size int, maxWait time.Duration, clock Clock) (iter.Seq[[]Source], error) {
+	if ctx == nil {
+		return nil, newOperatorError("ChunkTimeoutClock", "ctx", ErrNilContext)
+	}
+	if source == nil {
+		return nil, newOperatorError("ChunkTimeoutClock", "source", ErrNilSource)
+	}
+	if size <= 0 {
+		return nil, newOperatorError("ChunkTimeoutClock", "size", ErrSizeOutOfRange)
+	}
+	if maxWait <= 0 {
+		return nil, newOperatorError("ChunkTimeoutClock", "maxWait", ErrNonPositiveWait)
+	}
+	if clock == nil {
+		return nil, newOperatorError("ChunkTimeoutClock", "clock", ErrNilClock)
+	}
+	return func(yield func([]Source) bool) {
+			done := make(chan struct{})
+			defer close(done)
+			items := make(chan Source)
+			go func() {
+				defer close(items)
+				for s := range source {
+					select {
+					case items <- s:
+					case <-done:
+						return
+					}
+				}
+			}()
+			var chunk []Source
+			// timer is created for the first chunk and then reused, so no timer outlives the enumeration
+			var timer Timer
+			defer func() {
+				if timer != nil {
+					timer.Stop()
+				}
+			}()
+			// timeout is nil (blocks forever) while chunk is empty
+			var timeout <-chan time.Time
+			flush := func() bool {
+				c := chunk
+				chunk, timeout = nil, nil
+				return yield(c)
+			}
+			// stop disarms the timer before a chunk that has not timed out is flushed
+			stop := func() {
+				if !timer.Stop() {
+					// before Go 1.23 an expired timer's channel may still hold a value
+					select {
+					case <-timer.C():
+					default:
+					}
+				}
+			}
+			for {
+				select {
+				case s, ok := <-items:
+					if !ok {
+						if len(chunk) > 0 {
+							flush()
+						}
+						return
+					}
+					if len(chunk) == 0 {
+						if timer == nil {
+							timer = clock.NewTimer(maxWait)
+						} else {
+							timer.Reset(maxWait)
+						}
+						timeout = timer.C()
+					}
+					chunk = append(chunk, s)
+					if len(chunk) == size {
+						stop()
+						if !flush() {
+							return
+						}
+					}
+				case <-timeout:
+					if !flush() {
+						return
+					}
+				case <-ctx.Done():
+					if len(chunk) > 0 {
+						flush()
+					}
+					return
+				}
+			}
+		},
+		nil
+}
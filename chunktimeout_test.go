@@ -0,0 +1,209 @@
+package go2linq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a [Clock] whose time is advanced manually.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []*fakeTimer
+	created int
+	// armed receives a value each time a timer is created or reset
+	armed chan struct{}
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(0, 0), armed: make(chan struct{}, 100)}
+}
+
+func (fc *fakeClock) NewTimer(d time.Duration) Timer {
+	t := &fakeTimer{fc: fc, c: make(chan time.Time, 1)}
+	fc.mu.Lock()
+	fc.created++
+	fc.mu.Unlock()
+	t.Reset(d)
+	return t
+}
+
+// Advance advances the clock by 'd' and fires the expired timers.
+func (fc *fakeClock) Advance(d time.Duration) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.now = fc.now.Add(d)
+	var pending []*fakeTimer
+	for _, t := range fc.timers {
+		if t.deadline.After(fc.now) {
+			pending = append(pending, t)
+		} else {
+			select {
+			case t.c <- fc.now:
+			default:
+			}
+		}
+	}
+	fc.timers = pending
+}
+
+// fakeTimer is a [Timer] created by [fakeClock].
+type fakeTimer struct {
+	fc       *fakeClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	active := t.Stop()
+	t.fc.mu.Lock()
+	t.deadline = t.fc.now.Add(d)
+	t.fc.timers = append(t.fc.timers, t)
+	t.fc.mu.Unlock()
+	t.fc.armed <- struct{}{}
+	return active
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.fc.mu.Lock()
+	defer t.fc.mu.Unlock()
+	for i, ft := range t.fc.timers {
+		if ft == t {
+			t.fc.timers = append(t.fc.timers[:i], t.fc.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// collect enumerates 'chunks' in a separate goroutine sending each chunk to the returned channel.
+func collect(chunks iter.Seq[[]int]) <-chan string {
+	out := make(chan string)
+	go func() {
+		defer close(out)
+		for c := range chunks {
+			out <- fmt.Sprint(c)
+		}
+	}()
+	return out
+}
+
+func TestChunkTimeout_errors(t *testing.T) {
+	ctx := context.Background()
+	if _, err := ChunkTimeout(nil, VarAll(1), 1, time.Second); !errors.Is(err, ErrNilContext) {
+		t.Errorf("ChunkTimeout() error = %v, expectedErr %v", err, ErrNilContext)
+	}
+	if _, err := ChunkTimeoutClock(nil, VarAll(1), 1, time.Second, newFakeClock()); !errors.Is(err, ErrNilContext) {
+		t.Errorf("ChunkTimeoutClock() error = %v, expectedErr %v", err, ErrNilContext)
+	}
+	if _, err := ChunkTimeout[int](ctx, nil, 1, time.Second); !errors.Is(err, ErrNilSource) {
+		t.Errorf("ChunkTimeout() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := ChunkTimeout(ctx, VarAll(1), 0, time.Second); !errors.Is(err, ErrSizeOutOfRange) {
+		t.Errorf("ChunkTimeout() error = %v, expectedErr %v", err, ErrSizeOutOfRange)
+	}
+	if _, err := ChunkTimeout(ctx, VarAll(1), 1, 0); !errors.Is(err, ErrNonPositiveWait) {
+		t.Errorf("ChunkTimeout() error = %v, expectedErr %v", err, ErrNonPositiveWait)
+	}
+	if _, err := ChunkTimeoutClock(ctx, VarAll(1), 1, time.Second, nil); !errors.Is(err, ErrNilClock) {
+		t.Errorf("ChunkTimeoutClock() error = %v, expectedErr %v", err, ErrNilClock)
+	}
+}
+
+func TestChunkTimeout_size(t *testing.T) {
+	chunks, _ := ChunkTimeoutClock(context.Background(), RangeMust(1, 7), 3, time.Second, newFakeClock())
+	if got := StringDef(chunks); got != "[[1 2 3] [4 5 6] [7]]" {
+		t.Errorf("ChunkTimeoutClock() = %v, want [[1 2 3] [4 5 6] [7]]", got)
+	}
+	first, _ := Take(chunks, 1)
+	if got := StringDef(first); got != "[[1 2 3]]" {
+		t.Errorf("ChunkTimeoutClock() = %v, want [[1 2 3]]", got)
+	}
+}
+
+func TestChunkTimeout_timeout(t *testing.T) {
+	clock := newFakeClock()
+	c := make(chan int)
+	chunks, _ := ChunkTimeoutClock(context.Background(), ChanAll(c), 3, time.Second, clock)
+	out := collect(chunks)
+
+	c <- 1
+	<-clock.armed
+	clock.Advance(999 * time.Millisecond)
+	select {
+	case got := <-out:
+		t.Fatalf("chunk %v was yielded before maxWait", got)
+	default:
+	}
+	clock.Advance(time.Millisecond)
+	if got := <-out; got != "[1]" {
+		t.Errorf("chunk after timeout = %v, want [1]", got)
+	}
+
+	c <- 2
+	c <- 3
+	c <- 4
+	if got := <-out; got != "[2 3 4]" {
+		t.Errorf("chunk after size = %v, want [2 3 4]", got)
+	}
+
+	c <- 5
+	close(c)
+	if got := <-out; got != "[5]" {
+		t.Errorf("chunk at the end = %v, want [5]", got)
+	}
+	if got, ok := <-out; ok {
+		t.Errorf("unexpected chunk %v", got)
+	}
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	if clock.created != 1 {
+		t.Errorf("%d timers were created, want 1", clock.created)
+	}
+	if len(clock.timers) != 0 {
+		t.Errorf("%d timers are still active, want 0", len(clock.timers))
+	}
+}
+
+func TestChunkTimeout_cancel(t *testing.T) {
+	clock := newFakeClock()
+	c := make(chan int)
+	ctx, cancel := context.WithCancel(context.Background())
+	chunks, _ := ChunkTimeoutClock(ctx, ChanAll(c), 3, time.Second, clock)
+	out := collect(chunks)
+
+	c <- 1
+	<-clock.armed
+	cancel()
+	if got := <-out; got != "[1]" {
+		t.Errorf("chunk after cancel = %v, want [1]", got)
+	}
+	if got, ok := <-out; ok {
+		t.Errorf("unexpected chunk %v", got)
+	}
+}
+
+func ExampleChunkTimeout() {
+	rows := make(chan string, 5)
+	for _, r := range []string{"a", "b", "c", "d", "e"} {
+		rows <- r
+	}
+	close(rows)
+	// a batch is flushed when it has 3 rows, after a second since its first row or when the source ends
+	batches, _ := ChunkTimeout(context.Background(), ChanAll(rows), 3, time.Second)
+	for b := range batches {
+		fmt.Println(b)
+	}
+	// Output:
+	// [a b c]
+	// [d e]
+}
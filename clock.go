@@ -0,0 +1,41 @@
+package go2linq
+
+import (
+	"time"
+)
+
+// Clock is a source of timers for time-dependent operators (see [ChunkTimeoutClock]).
+// Clock allows to replace real time in tests.
+type Clock interface {
+	// NewTimer creates a new [Timer] that sends the current time on its channel after at least duration 'd'.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single event timer created by [Clock].
+// Timer's methods have the semantics of the corresponding [time.Timer] methods.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan time.Time
+	// Reset changes the timer to expire after duration 'd'.
+	Reset(d time.Duration) bool
+	// Stop prevents the timer from firing.
+	Stop() bool
+}
+
+// SystemClock is a [Clock] based on the [time] package.
+type SystemClock struct{}
+
+// NewTimer implements the [Clock] interface using [time.NewTimer].
+func (SystemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+// systemTimer is a [Timer] based on [time.Timer].
+type systemTimer struct {
+	*time.Timer
+}
+
+// C implements the [Timer] interface.
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
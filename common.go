@@ -1,5 +1,9 @@
 package go2linq
 
+import (
+	"golang.org/x/exp/constraints"
+)
+
 type (
 	// Counter is the interface that wraps the Count method.
 	Counter interface {
@@ -18,6 +22,11 @@ type (
 		// Slice returns the sequence contents as a slice.
 		Slice() []T
 	}
+
+	// Number is a constraint that permits any integer or floating-point type.
+	Number interface {
+		constraints.Integer | constraints.Float
+	}
 )
 
 // Identity is a selector that projects the element into itself.
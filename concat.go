@@ -8,8 +8,11 @@ import (
 //
 // [Concat]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.concat
 func Concat[Source any](first, second iter.Seq[Source]) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("Concat", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("Concat", "second", ErrNilSource)
 	}
 	return func(yield func(Source) bool) {
 			for s1 := range first {
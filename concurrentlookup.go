@@ -0,0 +1,98 @@
+package go2linq
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// ConcurrentLookup is a [Lookup] that is safe for concurrent use by multiple goroutines.
+// Sequences returned by ConcurrentLookup's methods iterate over snapshots of the data,
+// so readers never see partially updated groupings.
+type ConcurrentLookup[Key, Element any] struct {
+	mu sync.RWMutex
+	lk Lookup[Key, Element]
+}
+
+// NewConcurrentLookup creates an empty [ConcurrentLookup] that uses 'comparer' to compare keys.
+// Keys are distributed into buckets by the comparer's hash, as in [Lookup].
+func NewConcurrentLookup[Key, Element any](comparer EqualityComparer[Key]) (*ConcurrentLookup[Key, Element], error) {
+	if comparer == nil {
+		return nil, newOperatorError("NewConcurrentLookup", "comparer", ErrNilComparer)
+	}
+	cl := &ConcurrentLookup[Key, Element]{lk: Lookup[Key, Element]{groupings: []Grouping[Key, Element]{}}}
+	cl.lk.setComparer(comparer)
+	return cl, nil
+}
+
+// Add adds element 'el' with specified 'key' to 'cl'.
+func (cl *ConcurrentLookup[Key, Element]) Add(key Key, el Element) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	cl.lk.Add(key, el)
+}
+
+// Count gets the number of key/value collection pairs in the ConcurrentLookup.
+func (cl *ConcurrentLookup[Key, Element]) Count() int {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+	return cl.lk.Count()
+}
+
+// Contains determines whether a specified key is in the ConcurrentLookup.
+func (cl *ConcurrentLookup[Key, Element]) Contains(key Key) bool {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+	return cl.lk.Contains(key)
+}
+
+// ItemCount returns the number of values indexed by a specified key.
+func (cl *ConcurrentLookup[Key, Element]) ItemCount(key Key) int {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+	return cl.lk.ItemCount(key)
+}
+
+// Item gets the snapshot of the collection of values indexed by a specified key.
+func (cl *ConcurrentLookup[Key, Element]) Item(key Key) iter.Seq[Element] {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+	return SliceAll(slices.Clone(cl.lk.itemSlice(key)))
+}
+
+// Keys returns the snapshot of the ConcurrentLookup's keys.
+func (cl *ConcurrentLookup[Key, Element]) Keys() iter.Seq[Key] {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+	kk := make([]Key, len(cl.lk.groupings))
+	for i, g := range cl.lk.groupings {
+		kk[i] = g.key
+	}
+	return SliceAll(kk)
+}
+
+// All returns a sequence2 of the snapshot of the ConcurrentLookup's keys and corresponding values
+// in the groupings' order. (See [Lookup.All].)
+func (cl *ConcurrentLookup[Key, Element]) All() iter.Seq2[Key, iter.Seq[Element]] {
+	return cl.Snapshot().All()
+}
+
+// Groupings returns a sequence of the snapshot of the ConcurrentLookup's groupings. (See [Lookup.Groupings].)
+func (cl *ConcurrentLookup[Key, Element]) Groupings() iter.Seq[Grouping[Key, Element]] {
+	return cl.Snapshot().Groupings()
+}
+
+// Remove removes a specified key and its values from the ConcurrentLookup.
+// Remove returns false if 'key' is not in the ConcurrentLookup.
+func (cl *ConcurrentLookup[Key, Element]) Remove(key Key) bool {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	return cl.lk.Remove(key)
+}
+
+// Snapshot returns a [Lookup] containing a copy of the ConcurrentLookup's data.
+func (cl *ConcurrentLookup[Key, Element]) Snapshot() *Lookup[Key, Element] {
+	cl.mu.RLock()
+	defer cl.mu.RUnlock()
+	return cl.lk.Clone()
+}
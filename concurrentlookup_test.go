@@ -0,0 +1,117 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestNewConcurrentLookup(t *testing.T) {
+	if _, err := NewConcurrentLookup[int, string](nil); !errors.Is(err, ErrNilComparer) {
+		t.Errorf("NewConcurrentLookup() error = %v, expectedErr %v", err, ErrNilComparer)
+	}
+	cl, _ := NewConcurrentLookup[string, int](ComparerFunc[string]{EqualFunc: caseInsensitiveEqual, HashFunc: caseInsensitiveHash})
+	cl.Add("a", 1)
+	cl.Add("B", 2)
+	cl.Add("A", 3)
+	if cl.Count() != 2 || !cl.Contains("b") || cl.ItemCount("a") != 2 {
+		t.Errorf("ConcurrentLookup = %v", cl.Snapshot())
+	}
+	if got := StringDef(cl.Item("A")); got != "[1 3]" {
+		t.Errorf("Item(\"A\") = %v, want [1 3]", got)
+	}
+	if got := StringDef(cl.Keys()); got != "[a B]" {
+		t.Errorf("Keys() = %v, want [a B]", got)
+	}
+	var all []string
+	for k, vv := range cl.All() {
+		all = append(all, fmt.Sprint(k, StringDef(vv)))
+	}
+	if got := fmt.Sprint(all); got != "[a[1 3] B[2]]" {
+		t.Errorf("All() = %v, want [a[1 3] B[2]]", got)
+	}
+	var keys []string
+	for g := range cl.Groupings() {
+		keys = append(keys, g.Key())
+	}
+	if got := fmt.Sprint(keys); got != "[a B]" {
+		t.Errorf("Groupings() keys = %v, want [a B]", got)
+	}
+	if !cl.Remove("b") || cl.Contains("B") {
+		t.Errorf("Remove(\"b\") failed")
+	}
+}
+
+func TestConcurrentLookup_snapshot(t *testing.T) {
+	cl, _ := NewConcurrentLookup[int, int](OrderedComparer[int]())
+	cl.Add(1, 1)
+	item := cl.Item(1)
+	keys := cl.Keys()
+	groupings := cl.Groupings()
+	snapshot := cl.Snapshot()
+	cl.Add(1, 2)
+	cl.Add(2, 2)
+	if got := StringDef(item); got != "[1]" {
+		t.Errorf("Item(1) snapshot = %v, want [1]", got)
+	}
+	if got := StringDef(keys); got != "[1]" {
+		t.Errorf("Keys() snapshot = %v, want [1]", got)
+	}
+	n := 0
+	for g := range groupings {
+		n += len(g.values)
+	}
+	if n != 1 {
+		t.Errorf("Groupings() snapshot has %d values, want 1", n)
+	}
+	if snapshot.Count() != 1 || snapshot.ItemCount(1) != 1 {
+		t.Errorf("Snapshot() = %v, changed after Add", snapshot)
+	}
+}
+
+// run with -race
+func TestConcurrentLookup_race(t *testing.T) {
+	const writers, perWriter, keys = 8, 500, 10
+	cl, _ := NewConcurrentLookup[int, string](DeepComparer[int]())
+	var wg sync.WaitGroup
+	for w := range writers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWriter {
+				cl.Add(i%keys, fmt.Sprintf("%d-%d", w, i))
+			}
+		}()
+	}
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWriter {
+				n := 0
+				for range cl.Item(i % keys) {
+					n++
+				}
+				if c := cl.ItemCount(i % keys); c < n {
+					t.Errorf("ItemCount() = %d is less than number of previously seen items %d", c, n)
+				}
+				for range cl.Keys() {
+				}
+				for range cl.Groupings() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if cl.Count() != keys {
+		t.Errorf("Count() = %d, want %d", cl.Count(), keys)
+	}
+	total := 0
+	for k := range cl.Keys() {
+		total += cl.ItemCount(k)
+	}
+	if total != writers*perWriter {
+		t.Errorf("total items = %d, want %d", total, writers*perWriter)
+	}
+}
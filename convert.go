@@ -0,0 +1,99 @@
+package go2linq
+
+import (
+	"iter"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// isFloat reports whether T is a floating-point type.
+func isFloat[T Number]() bool {
+	half := 0.5
+	return T(half) != 0
+}
+
+// isSigned reports whether T is a signed type.
+func isSigned[T Number]() bool {
+	var t T
+	return t-1 < 0
+}
+
+// integerBounds returns inclusive lower and exclusive upper bounds of the integer type T as float64.
+func integerBounds[T Number]() (float64, float64) {
+	var t T
+	bits := 8 * int(unsafe.Sizeof(t))
+	if isSigned[T]() {
+		return -math.Ldexp(1, bits-1), math.Ldexp(1, bits-1)
+	}
+	return 0, math.Ldexp(1, bits)
+}
+
+// convertNumber converts 'v' to type To.
+// convertNumber returns [ErrOverflow] if 'v' is out of range of To
+// and [ErrTruncation] if the conversion loses fractional part or precision.
+func convertNumber[From, To Number](v From) (To, error) {
+	var t0 To
+	if isFloat[From]() {
+		f := float64(v)
+		if !isFloat[To]() {
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return t0, ErrOverflow
+			}
+			if f != math.Trunc(f) {
+				return t0, ErrTruncation
+			}
+			lo, hi := integerBounds[To]()
+			if f < lo || f >= hi {
+				return t0, ErrOverflow
+			}
+			return To(v), nil
+		}
+		t := To(v)
+		if math.IsInf(float64(t), 0) && !math.IsInf(f, 0) {
+			return t0, ErrOverflow
+		}
+		if float64(t) != f && !math.IsNaN(f) {
+			return t0, ErrTruncation
+		}
+		return t, nil
+	}
+	t := To(v)
+	if isFloat[To]() {
+		// integer to floating-point: the value must survive the round trip
+		lo, hi := integerBounds[From]()
+		if f := float64(t); f < lo || f >= hi || From(t) != v {
+			return t0, ErrTruncation
+		}
+		return t, nil
+	}
+	if (v < 0) != (t < 0) || From(t) != v {
+		return t0, ErrOverflow
+	}
+	return t, nil
+}
+
+// Convert converts the elements of a sequence of numbers to a specified numeric type without loss.
+// If an element cannot be represented exactly in type To, Convert yields
+// zero value paired with [ConversionError] for such an element and stops.
+func Convert[From, To Number](source iter.Seq[From]) (iter.Seq2[To, error], error) {
+	if source == nil {
+		return nil, newOperatorError("Convert", "source", ErrNilSource)
+	}
+	return func(yield func(To, error) bool) {
+			i := 0
+			for s := range source {
+				t, err := convertNumber[From, To](s)
+				if err != nil {
+					var t0 To
+					yield(t0, &ConversionError{Index: i, Value: s, Target: reflect.TypeFor[To](), Err: err})
+					return
+				}
+				if !yield(t, nil) {
+					return
+				}
+				i++
+			}
+		},
+		nil
+}
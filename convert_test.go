@@ -0,0 +1,134 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func Test_convertNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		conv    func() (any, error)
+		want    any
+		wantErr error
+	}{
+		{name: "int to int8",
+			conv: func() (any, error) { return convertNumber[int, int8](-128) },
+			want: int8(-128),
+		},
+		{name: "int to int8 overflow",
+			conv:    func() (any, error) { return convertNumber[int, int8](300) },
+			wantErr: ErrOverflow,
+		},
+		{name: "negative int to uint64",
+			conv:    func() (any, error) { return convertNumber[int8, uint64](-1) },
+			wantErr: ErrOverflow,
+		},
+		{name: "uint64 to int64 overflow",
+			conv:    func() (any, error) { return convertNumber[uint64, int64](math.MaxUint64) },
+			wantErr: ErrOverflow,
+		},
+		{name: "float64 to int",
+			conv: func() (any, error) { return convertNumber[float64, int](42) },
+			want: 42,
+		},
+		{name: "float64 to int truncation",
+			conv:    func() (any, error) { return convertNumber[float64, int](42.5) },
+			wantErr: ErrTruncation,
+		},
+		{name: "float64 to uint8 overflow",
+			conv:    func() (any, error) { return convertNumber[float64, uint8](256) },
+			wantErr: ErrOverflow,
+		},
+		{name: "float64 to uint8 negative",
+			conv:    func() (any, error) { return convertNumber[float64, uint8](-1) },
+			wantErr: ErrOverflow,
+		},
+		{name: "float64 to int64 max",
+			conv:    func() (any, error) { return convertNumber[float64, int64](math.Ldexp(1, 63)) },
+			wantErr: ErrOverflow,
+		},
+		{name: "NaN to int",
+			conv:    func() (any, error) { return convertNumber[float64, int](math.NaN()) },
+			wantErr: ErrOverflow,
+		},
+		{name: "float64 to float32",
+			conv: func() (any, error) { return convertNumber[float64, float32](0.5) },
+			want: float32(0.5),
+		},
+		{name: "float64 to float32 truncation",
+			conv:    func() (any, error) { return convertNumber[float64, float32](0.1) },
+			wantErr: ErrTruncation,
+		},
+		{name: "float64 to float32 overflow",
+			conv:    func() (any, error) { return convertNumber[float64, float32](1e300) },
+			wantErr: ErrOverflow,
+		},
+		{name: "int64 to float64",
+			conv: func() (any, error) { return convertNumber[int64, float64](1 << 53) },
+			want: float64(1 << 53),
+		},
+		{name: "int64 to float64 truncation",
+			conv:    func() (any, error) { return convertNumber[int64, float64](1<<53 + 1) },
+			wantErr: ErrTruncation,
+		},
+		{name: "int64 max to float64",
+			conv:    func() (any, error) { return convertNumber[int64, float64](math.MaxInt64) },
+			wantErr: ErrTruncation,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.conv()
+			if err != tt.wantErr {
+				t.Fatalf("convertNumber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("convertNumber() = %v (%T), want %v (%T)", got, got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	if _, err := Convert[int, int8](nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Convert() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	seq2, _ := Convert[int, uint8](VarAll(1, 255, 256, 3))
+	var got []uint8
+	var gotErr error
+	for v, err := range seq2 {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, v)
+	}
+	if fmt.Sprint(got) != "[1 255]" {
+		t.Errorf("Convert() = %v, want [1 255]", got)
+	}
+	var ce *ConversionError
+	if !errors.As(gotErr, &ce) || !errors.Is(gotErr, ErrOverflow) || ce.Index != 2 || ce.Value != 256 {
+		t.Errorf("Convert() error = %v, want ConversionError at index 2", gotErr)
+	}
+}
+
+func ExampleConvert() {
+	// numbers decoded from JSON into []any are float64
+	payload := []any{1.0, 2.0, 2.5}
+	floats, _ := OfType[any, float64](SliceAll(payload))
+	ints, _ := Convert[float64, int](floats)
+	for i, err := range ints {
+		if err != nil {
+			fmt.Println(err)
+			break
+		}
+		fmt.Println(i)
+	}
+	// Output:
+	// 1
+	// 2
+	// truncation: element 2 (2.5) to int
+}
@@ -0,0 +1,30 @@
+package go2linq
+
+import (
+	"iter"
+)
+
+// Cycle repeats the elements of 'source' endlessly.
+// 'source' is enumerated anew on each repetition.
+// Cycle ends if an enumeration of 'source' yields no elements,
+// so an empty or a single-use 'source' does not make Cycle loop forever.
+func Cycle[Source any](source iter.Seq[Source]) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("Cycle", "source", ErrNilSource)
+	}
+	return func(yield func(Source) bool) {
+			for {
+				empty := true
+				for s := range source {
+					empty = false
+					if !yield(s) {
+						return
+					}
+				}
+				if empty {
+					return
+				}
+			}
+		},
+		nil
+}
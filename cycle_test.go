@@ -0,0 +1,65 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"testing"
+)
+
+func TestCycle(t *testing.T) {
+	if _, err := Cycle[int](nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Cycle() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	used := false
+	singleUse := func(yield func(int) bool) {
+		if used {
+			return
+		}
+		used = true
+		for _, i := range []int{1, 2} {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	tests := []struct {
+		name   string
+		source iter.Seq[int]
+		take   int
+		want   string
+	}{
+		{name: "Empty", source: Empty[int](), take: 5, want: "[]"},
+		{name: "Single", source: VarAll(7), take: 3, want: "[7 7 7]"},
+		{name: "Multiple", source: VarAll(1, 2, 3), take: 7, want: "[1 2 3 1 2 3 1]"},
+		{name: "SingleUse", source: singleUse, take: 5, want: "[1 2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := Cycle(tt.source)
+			got, _ := Take(c, tt.take)
+			if s := StringDef(got); s != tt.want {
+				t.Errorf("Cycle() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleCycle() {
+	weekdays, _ := Cycle(VarAll("Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"))
+	shifts, _ := Zip(weekdays, VarAll("Ann", "Bob", "Cid", "Dan", "Eve", "Fay", "Gus", "Hal", "Ivy"),
+		func(day, name string) string { return day + " " + name })
+	for s := range shifts {
+		fmt.Println(s)
+	}
+	// Output:
+	// Mon Ann
+	// Tue Bob
+	// Wed Cid
+	// Thu Dan
+	// Fri Eve
+	// Sat Fay
+	// Sun Gus
+	// Mon Hal
+	// Tue Ivy
+}
@@ -0,0 +1,128 @@
+package go2linq
+
+import (
+	"hash/maphash"
+	"math"
+	"reflect"
+
+	"github.com/solsw/generichelper"
+)
+
+// deepHashMaxDepth limits the number of pointers, slices and maps followed by [DeepHash].
+// The limit protects DeepHash from cyclic data. Values that differ only beyond the limit get equal hash codes.
+const deepHashMaxDepth = 16
+
+const (
+	hashNil  uint64 = 0x9ae16a3b2f90404f
+	hashFunc uint64 = 0xc949d7c7509e6557
+)
+
+// DeepHash returns a hash code for 't' that agrees with [generichelper.DeepEqual]
+// (i.e. with [reflect.DeepEqual]): deeply equal values have equal hash codes.
+//
+// Slices and arrays are hashed element by element, maps are hashed independently of iteration order,
+// pointers are hashed by the values they point to, structs are hashed field by field
+// (unexported fields included), and all NaNs have the same hash code, as do 0.0 and -0.0.
+// Channels and unsafe pointers are hashed by identity. Non-nil functions all have the same hash code.
+func DeepHash[T any](t T) uint64 {
+	return deepHashValue(reflect.ValueOf(&t).Elem(), 0)
+}
+
+func deepHashValue(v reflect.Value, depth int) uint64 {
+	if !v.IsValid() {
+		return hashNil
+	}
+	h := uint64(v.Kind())
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return combineHash(h, 1)
+		}
+		return combineHash(h, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return combineHash(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return combineHash(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return combineHash(h, floatHash(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return combineHash(combineHash(h, floatHash(real(c))), floatHash(imag(c)))
+	case reflect.String:
+		return combineHash(h, maphash.String(hashSeed, v.String()))
+	case reflect.Array:
+		for i := range v.Len() {
+			h = combineHash(h, deepHashValue(v.Index(i), depth))
+		}
+		return h
+	case reflect.Struct:
+		for i := range v.NumField() {
+			h = combineHash(h, deepHashValue(v.Field(i), depth))
+		}
+		return h
+	case reflect.Interface:
+		if v.IsNil() {
+			return hashNil
+		}
+		return deepHashValue(v.Elem(), depth)
+	case reflect.Pointer:
+		if v.IsNil() {
+			return hashNil
+		}
+		if depth >= deepHashMaxDepth {
+			return h
+		}
+		return combineHash(h, deepHashValue(v.Elem(), depth+1))
+	case reflect.Slice:
+		if v.IsNil() {
+			return hashNil
+		}
+		h = combineHash(h, uint64(v.Len()))
+		if depth >= deepHashMaxDepth {
+			return h
+		}
+		for i := range v.Len() {
+			h = combineHash(h, deepHashValue(v.Index(i), depth+1))
+		}
+		return h
+	case reflect.Map:
+		if v.IsNil() {
+			return hashNil
+		}
+		h = combineHash(h, uint64(v.Len()))
+		if depth >= deepHashMaxDepth {
+			return h
+		}
+		// sum of entries' hash codes does not depend on iteration order
+		var sum uint64
+		for mi := v.MapRange(); mi.Next(); {
+			sum += combineHash(deepHashValue(mi.Key(), depth+1), deepHashValue(mi.Value(), depth+1))
+		}
+		return combineHash(h, sum)
+	case reflect.Func:
+		if v.IsNil() {
+			return hashNil
+		}
+		return hashFunc
+	case reflect.Chan, reflect.UnsafePointer:
+		return combineHash(h, uint64(v.Pointer()))
+	}
+	return h
+}
+
+// floatHash returns a hash code for 'f' treating all NaNs as equal and 0.0 as equal to -0.0.
+func floatHash(f float64) uint64 {
+	switch {
+	case math.IsNaN(f):
+		return math.Float64bits(math.NaN())
+	case f == 0:
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+// DeepComparer returns an [EqualityComparer] that compares values using [generichelper.DeepEqual]
+// and hashes them using [DeepHash].
+func DeepComparer[T any]() EqualityComparer[T] {
+	return ComparerFunc[T]{EqualFunc: generichelper.DeepEqual[T], HashFunc: DeepHash[T]}
+}
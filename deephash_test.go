@@ -0,0 +1,98 @@
+package go2linq
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+type deepNode struct {
+	Val  int
+	Next *deepNode
+	tags map[string][]int
+}
+
+func TestDeepHash_agreesWithDeepEqual(t *testing.T) {
+	n1, n2 := 1, 1
+	ring1 := &deepNode{Val: 1}
+	ring1.Next = ring1
+	ring2 := &deepNode{Val: 1}
+	ring2.Next = ring2
+	nans := []float64{math.NaN()}
+	tests := []struct {
+		name string
+		x, y any
+	}{
+		{name: "Int", x: 42, y: 42},
+		{name: "String", x: "go2linq", y: "go2linq"},
+		{name: "Zeros", x: 0.0, y: math.Copysign(0, -1)},
+		{name: "Slice", x: []int{1, 2, 3}, y: []int{1, 2, 3}},
+		{name: "SameNaNSlice", x: nans, y: nans},
+		{name: "NestedSlice", x: [][]string{{"a"}, {"b", "c"}}, y: [][]string{{"a"}, {"b", "c"}}},
+		{name: "Array", x: [2]bool{true, false}, y: [2]bool{true, false}},
+		{name: "Map", x: map[string]int{"a": 1, "b": 2, "c": 3}, y: map[string]int{"c": 3, "b": 2, "a": 1}},
+		{name: "PointerByTarget", x: &n1, y: &n2},
+		{name: "Struct",
+			x: deepNode{Val: 1, Next: &deepNode{Val: 2}, tags: map[string][]int{"x": {1}}},
+			y: deepNode{Val: 1, Next: &deepNode{Val: 2}, tags: map[string][]int{"x": {1}}},
+		},
+		{name: "Cycle", x: ring1, y: ring2},
+		{name: "Interface", x: []any{1, "a", nil}, y: []any{1, "a", nil}},
+		{name: "NilFunc", x: (func())(nil), y: (func())(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.x, tt.y) {
+				t.Fatalf("DeepEqual(%v, %v) = false", tt.x, tt.y)
+			}
+			if hx, hy := DeepHash(tt.x), DeepHash(tt.y); hx != hy {
+				t.Errorf("DeepHash(%v) = %x, DeepHash(%v) = %x", tt.x, hx, tt.y, hy)
+			}
+		})
+	}
+}
+
+func TestDeepHash_different(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y any
+	}{
+		{name: "Int", x: 1, y: 2},
+		{name: "Slice", x: []int{1, 2}, y: []int{2, 1}},
+		{name: "SliceLength", x: []int{0}, y: []int{0, 0}},
+		{name: "Map", x: map[string]int{"a": 1, "b": 2}, y: map[string]int{"a": 2, "b": 1}},
+		{name: "Struct", x: deepNode{Val: 1}, y: deepNode{Val: 1, Next: &deepNode{}}},
+		{name: "NaN", x: math.NaN(), y: 1.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if DeepHash(tt.x) == DeepHash(tt.y) {
+				t.Errorf("DeepHash(%v) == DeepHash(%v)", tt.x, tt.y)
+			}
+		})
+	}
+}
+
+func TestDistinct_hashBuckets(t *testing.T) {
+	got, _ := Distinct(VarAll([]int{1, 2}, []int{3}, []int{1, 2}, nil, []int{3}, nil))
+	if s := StringDef(got); s != "[[1 2] [3] []]" {
+		t.Errorf("Distinct() = %v, want [[1 2] [3] []]", s)
+	}
+	groupBy, _ := GroupBy(VarAll("a", "bb", "cc", "d"), func(s string) map[int]bool { return map[int]bool{len(s): true} })
+	gg, _ := ToSlice(groupBy)
+	if len(gg) != 2 || StringDef(gg[1].Values()) != "[bb cc]" {
+		t.Errorf("GroupBy() with map keys = %v", gg)
+	}
+}
+
+func BenchmarkDistinct_slices(b *testing.B) {
+	ss := make([][]int, 2000)
+	for i := range ss {
+		ss[i] = []int{i % 500, i % 7}
+	}
+	for i := 0; i < b.N; i++ {
+		distinct, _ := Distinct(SliceAll(ss))
+		for range distinct {
+		}
+	}
+}
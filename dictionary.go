@@ -0,0 +1,134 @@
+package go2linq
+
+import (
+	"iter"
+
+	"github.com/solsw/generichelper"
+)
+
+// Dictionary represents a collection of keys and values.
+// Dictionary keeps key/value pairs in the order the keys were added, except that [Dictionary.Remove]
+// moves the last pair into the place of the removed one.
+// Dictionary implements [Counter], [Itemer] and [Slicer] with key/value pairs
+// represented by [generichelper.Tuple2].
+type Dictionary[Key comparable, Value any] struct {
+	keys []Key
+	m    map[Key]dictionaryEntry[Value]
+}
+
+// dictionaryEntry is a value of a [Dictionary] along with the index of its key in 'keys'.
+type dictionaryEntry[Value any] struct {
+	value Value
+	index int
+}
+
+// NewDictionary creates an empty [Dictionary].
+func NewDictionary[Key comparable, Value any]() *Dictionary[Key, Value] {
+	return &Dictionary[Key, Value]{m: make(map[Key]dictionaryEntry[Value])}
+}
+
+// Add adds the specified key and value to the Dictionary.
+// Add returns [DuplicateKeyError] if the Dictionary already contains 'key'.
+func (d *Dictionary[Key, Value]) Add(key Key, value Value) error {
+	if _, ok := d.m[key]; ok {
+		return newOperatorError("Dictionary.Add", "key", &DuplicateKeyError[Key]{Key: key})
+	}
+	d.Set(key, value)
+	return nil
+}
+
+// Set sets the value associated with the specified key.
+func (d *Dictionary[Key, Value]) Set(key Key, value Value) {
+	e, ok := d.m[key]
+	if !ok {
+		e.index = len(d.keys)
+		d.keys = append(d.keys, key)
+	}
+	e.value = value
+	d.m[key] = e
+}
+
+// Get gets the value associated with the specified key.
+// Get returns false if the Dictionary does not contain 'key'.
+func (d *Dictionary[Key, Value]) Get(key Key) (Value, bool) {
+	e, ok := d.m[key]
+	return e.value, ok
+}
+
+// ContainsKey determines whether the Dictionary contains the specified key.
+func (d *Dictionary[Key, Value]) ContainsKey(key Key) bool {
+	_, ok := d.m[key]
+	return ok
+}
+
+// Remove removes the value with the specified key from the Dictionary.
+// Remove returns false if the Dictionary does not contain 'key'.
+// To take constant time, Remove moves the last key/value pair into the place of the removed one.
+func (d *Dictionary[Key, Value]) Remove(key Key) bool {
+	e, ok := d.m[key]
+	if !ok {
+		return false
+	}
+	delete(d.m, key)
+	last := len(d.keys) - 1
+	if e.index != last {
+		lk := d.keys[last]
+		d.keys[e.index] = lk
+		le := d.m[lk]
+		le.index = e.index
+		d.m[lk] = le
+	}
+	var k0 Key
+	d.keys[last] = k0
+	d.keys = d.keys[:last]
+	return true
+}
+
+// Count implements the [Counter] interface.
+func (d *Dictionary[Key, Value]) Count() int {
+	return len(d.keys)
+}
+
+// Item implements the [Itemer] interface.
+// Item returns the key/value pair at the specified index in the order the keys were added.
+// Item panics if 'index' is out of range.
+func (d *Dictionary[Key, Value]) Item(index int) generichelper.Tuple2[Key, Value] {
+	k := d.keys[index]
+	return generichelper.NewTuple2(k, d.m[k].value)
+}
+
+// Slice implements the [Slicer] interface.
+func (d *Dictionary[Key, Value]) Slice() []generichelper.Tuple2[Key, Value] {
+	tt := make([]generichelper.Tuple2[Key, Value], len(d.keys))
+	for i, k := range d.keys {
+		tt[i] = generichelper.NewTuple2(k, d.m[k].value)
+	}
+	return tt
+}
+
+// All returns a sequence of the Dictionary's key/value pairs.
+func (d *Dictionary[Key, Value]) All() iter.Seq[generichelper.Tuple2[Key, Value]] {
+	return func(yield func(generichelper.Tuple2[Key, Value]) bool) {
+		for _, k := range d.keys {
+			if !yield(generichelper.NewTuple2(k, d.m[k].value)) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence of the Dictionary's keys.
+func (d *Dictionary[Key, Value]) Keys() iter.Seq[Key] {
+	return SliceAll(d.keys)
+}
+
+// Values returns a sequence of the Dictionary's values.
+func (d *Dictionary[Key, Value]) Values() iter.Seq[Value] {
+	return func(yield func(Value) bool) {
+		for _, k := range d.keys {
+			if !yield(d.m[k].value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/solsw/generichelper"
+)
+
+var (
+	_ Itemer[generichelper.Tuple2[int, string]] = (*Dictionary[int, string])(nil)
+	_ Slicer[generichelper.Tuple2[int, string]] = (*Dictionary[int, string])(nil)
+)
+
+func TestDictionary(t *testing.T) {
+	d := NewDictionary[string, int]()
+	if err := d.Add("one", 1); err != nil {
+		t.Errorf("Add() error = %v", err)
+	}
+	d.Add("two", 2)
+	d.Add("three", 3)
+	if err := d.Add("one", 11); !errors.Is(err, ErrDuplicateKeys) {
+		t.Errorf("Add() error = %v, expectedErr %v", err, ErrDuplicateKeys)
+	}
+	d.Set("two", 22)
+	if v, ok := d.Get("two"); !ok || v != 22 {
+		t.Errorf("Get(\"two\") = %v, %v, want 22, true", v, ok)
+	}
+	if !d.Remove("one") || d.Remove("one") || d.ContainsKey("one") {
+		t.Errorf("Remove() failed")
+	}
+	// the last pair takes the place of the removed one
+	if got := StringDef(d.Keys()); got != "[three two]" {
+		t.Errorf("Keys() = %v, want [three two]", got)
+	}
+	if got := StringDef(d.Values()); got != "[3 22]" {
+		t.Errorf("Values() = %v, want [3 22]", got)
+	}
+	if d.Count() != 2 || d.Item(1) != generichelper.NewTuple2("two", 22) {
+		t.Errorf("Dictionary = %v", d.Slice())
+	}
+	if got := StringDef(d.All()); got != "[{three 3} {two 22}]" {
+		t.Errorf("All() = %v, want [{three 3} {two 22}]", got)
+	}
+	d.Set("four", 4)
+	if !d.Remove("two") || !d.Remove("three") || d.Item(0) != generichelper.NewTuple2("four", 4) || d.Count() != 1 {
+		t.Errorf("Dictionary after Remove() = %v", d.Slice())
+	}
+}
+
+func ExampleDictionary_All() {
+	d := NewDictionary[string, int]()
+	for _, w := range []string{"go", "linq", "go", "iter", "go"} {
+		n, _ := d.Get(w)
+		d.Set(w, n+1)
+	}
+	for kv := range d.All() {
+		fmt.Println(kv.Item1, kv.Item2)
+	}
+	// Output:
+	// go 3
+	// linq 1
+	// iter 1
+}
@@ -0,0 +1,336 @@
+package go2linq
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// EditKind is the kind of an [Edit] operation.
+type EditKind int
+
+const (
+	// EditKeep means that the element is present in both sequences.
+	EditKeep EditKind = iota
+	// EditDelete means that the element of the first sequence is absent in the second one.
+	EditDelete
+	// EditInsert means that the element of the second sequence is absent in the first one.
+	EditInsert
+)
+
+// String implements the [fmt.Stringer] interface.
+func (k EditKind) String() string {
+	switch k {
+	case EditKeep:
+		return "keep"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	}
+	return fmt.Sprintf("EditKind(%d)", int(k))
+}
+
+// Edit is an operation of an edit script that transforms one sequence into another.
+type Edit[T any] struct {
+	Kind EditKind
+	// FirstIndex is the index of the element in the first sequence (-1 for [EditInsert]).
+	FirstIndex int
+	// SecondIndex is the index of the element in the second sequence (-1 for [EditDelete]).
+	SecondIndex int
+	// Value is the element of the first sequence for [EditKeep] and [EditDelete]
+	// and the element of the second sequence for [EditInsert].
+	Value T
+}
+
+// String implements the [fmt.Stringer] interface.
+// String returns the Edit as a line of unified diff: the value prefixed with ' ', '-' or '+'.
+func (e Edit[T]) String() string {
+	prefix := " "
+	switch e.Kind {
+	case EditDelete:
+		prefix = "-"
+	case EditInsert:
+		prefix = "+"
+	}
+	return prefix + fmt.Sprint(e.Value)
+}
+
+// Diff returns a minimal edit script that transforms 'first' into 'second'
+// using a specified 'equal' to compare elements.
+// Diff uses the Myers algorithm, which takes O((N+M)·D) time and O(N+M+D²) space,
+// where N and M are the lengths of the sequences and D is the length of the edit script without keeps.
+// Within a run of changes deletions precede insertions.
+// 'first' and 'second' are enumerated when the result is enumerated.
+func Diff[T any](first, second iter.Seq[T], equal func(T, T) bool) (iter.Seq[Edit[T]], error) {
+	if first == nil {
+		return nil, newOperatorError("Diff", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("Diff", "second", ErrNilSource)
+	}
+	if equal == nil {
+		return nil, newOperatorError("Diff", "equal", ErrNilEqual)
+	}
+	return func(yield func(Edit[T]) bool) {
+			a, _ := ToSlice(first)
+			b, _ := ToSlice(second)
+			for _, e := range myersDiff(a, b, equal) {
+				if !yield(e) {
+					return
+				}
+			}
+		},
+		nil
+}
+
+// myersDiff returns the shortest edit script that transforms 'a' into 'b'.
+func myersDiff[T any](a, b []T, equal func(T, T) bool) []Edit[T] {
+	// common prefix and suffix are kept as is
+	pre := 0
+	for pre < len(a) && pre < len(b) && equal(a[pre], b[pre]) {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && equal(a[len(a)-1-suf], b[len(b)-1-suf]) {
+		suf++
+	}
+	edits := make([]Edit[T], 0, len(a)+len(b)-pre-suf)
+	for i := range pre {
+		edits = append(edits, Edit[T]{Kind: EditKeep, FirstIndex: i, SecondIndex: i, Value: a[i]})
+	}
+	edits = append(edits, myersMiddle(a[pre:len(a)-suf], b[pre:len(b)-suf], pre, equal)...)
+	for i := suf; i > 0; i-- {
+		ai, bi := len(a)-i, len(b)-i
+		edits = append(edits, Edit[T]{Kind: EditKeep, FirstIndex: ai, SecondIndex: bi, Value: a[ai]})
+	}
+	return edits
+}
+
+// myersMiddle returns the shortest edit script that transforms 'a' into 'b'.
+// 'offset' is added to the indices of the edits.
+func myersMiddle[T any](a, b []T, offset int, equal func(T, T) bool) []Edit[T] {
+	n, m := len(a), len(b)
+	if n == 0 && m == 0 {
+		return nil
+	}
+	// v[mid+k] is the furthest x reached on diagonal k (y = x-k);
+	// trace[d] keeps v[mid-d-1..mid+d+1] before the step d, so trace[d][d+1+k] is the state of diagonal k
+	// (the step d and its backtracking read diagonals -d-1..d+1 only, hence the whole trace takes O(D²) space)
+	mid := n + m + 1
+	v := make([]int, 2*mid+1)
+	var trace [][]int
+	// down reports whether the path to diagonal k on the step d goes down (insertion) rather than right (deletion);
+	// at(k) returns the state of diagonal k before the step d
+	down := func(at func(int) int, d, k int) bool {
+		return k == -d || (k != d && at(k-1) < at(k+1))
+	}
+	atV := func(k int) int { return v[mid+k] }
+found:
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v[mid-d-1:mid+d+2]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if down(atV, d, k) {
+				x = v[mid+k+1]
+			} else {
+				x = v[mid+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && equal(a[x], b[y]) {
+				x++
+				y++
+			}
+			v[mid+k] = x
+			if x >= n && y >= m {
+				break found
+			}
+		}
+	}
+	// backtrack from (n, m) to (0, 0)
+	var rev []Edit[T]
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		at := func(k int) int { return trace[d][d+1+k] }
+		k := x - y
+		prevK := k - 1
+		if down(at, d, k) {
+			prevK = k + 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			rev = append(rev, Edit[T]{Kind: EditKeep, FirstIndex: offset + x, SecondIndex: offset + y, Value: a[x]})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			rev = append(rev, Edit[T]{Kind: EditInsert, FirstIndex: -1, SecondIndex: offset + prevY, Value: b[prevY]})
+		} else {
+			rev = append(rev, Edit[T]{Kind: EditDelete, FirstIndex: offset + prevX, SecondIndex: -1, Value: a[prevX]})
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(rev)
+	return rev
+}
+
+// UnifiedDiff returns the text of the edit script 'edits' (see [Diff]) in unified diff format.
+// Each element is rendered on a separate line by [Edit.String].
+// Changes are grouped into hunks with 'context' unchanged lines around them;
+// each hunk starts with a header "@@ -l,s +l,s @@" containing 1-based start lines and lengths.
+// 'edits' must be a complete edit script. If it contains no changes, empty string is returned.
+func UnifiedDiff[T any](edits iter.Seq[Edit[T]], context int) string {
+	if edits == nil {
+		return ""
+	}
+	ee, _ := ToSlice(edits)
+	context = max(context, 0)
+	// first[i] and second[i] are the numbers of lines of the sequences preceding ee[i]
+	first := make([]int, len(ee)+1)
+	second := make([]int, len(ee)+1)
+	for i, e := range ee {
+		first[i+1], second[i+1] = first[i], second[i]
+		if e.Kind != EditInsert {
+			first[i+1]++
+		}
+		if e.Kind != EditDelete {
+			second[i+1]++
+		}
+	}
+	var b strings.Builder
+	for i := 0; i < len(ee); {
+		if ee[i].Kind == EditKeep {
+			i++
+			continue
+		}
+		// ee[i] is the first change of a hunk; find the end of the hunk
+		end := i
+		for j := i; j < len(ee); j++ {
+			if ee[j].Kind == EditKeep {
+				continue
+			}
+			if j-end-1 > 2*context {
+				break
+			}
+			end = j
+		}
+		lo, hi := max(i-context, 0), min(end+context+1, len(ee))
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n",
+			hunkRange(first[lo], first[hi]-first[lo]), hunkRange(second[lo], second[hi]-second[lo]))
+		for _, e := range ee[lo:hi] {
+			b.WriteString(e.String())
+			b.WriteByte('\n')
+		}
+		i = hi
+	}
+	return b.String()
+}
+
+// hunkRange returns the range of a unified diff hunk header
+// for 'count' lines that follow 'before' lines.
+func hunkRange(before, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", before)
+	case 1:
+		return fmt.Sprintf("%d", before+1)
+	}
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
+
+// ChangeKind is the kind of a [Change].
+type ChangeKind int
+
+const (
+	// ChangeAdded means that the record is present in the second snapshot only.
+	ChangeAdded ChangeKind = iota
+	// ChangeRemoved means that the record is present in the first snapshot only.
+	ChangeRemoved
+	// ChangeModified means that the records with the same key differ.
+	ChangeModified
+)
+
+// String implements the [fmt.Stringer] interface.
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeModified:
+		return "modified"
+	}
+	return fmt.Sprintf("ChangeKind(%d)", int(k))
+}
+
+// Change is a difference between two snapshots of keyed records (see [DiffBy]).
+type Change[Key, T any] struct {
+	Kind ChangeKind
+	Key  Key
+	// Old is the record of the first snapshot (zero value for [ChangeAdded]).
+	Old T
+	// New is the record of the second snapshot (zero value for [ChangeRemoved]).
+	New T
+}
+
+// String implements the [fmt.Stringer] interface.
+func (c Change[Key, T]) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("+%v: %v", c.Key, c.New)
+	case ChangeRemoved:
+		return fmt.Sprintf("-%v: %v", c.Key, c.Old)
+	}
+	return fmt.Sprintf("~%v: %v -> %v", c.Key, c.Old, c.New)
+}
+
+// DiffBy compares two snapshots of records identified by keys returned by 'keySelector'.
+// Keys are compared using [generichelper.DeepEqual], records with the same key are compared using 'equal'.
+// DiffBy returns removed and modified records in the order of 'first'
+// followed by added records in the order of 'second'. Unchanged records are omitted.
+// 'first' and 'second' are enumerated immediately.
+// If a key occurs more than once in a snapshot, [DuplicateKeyError] is returned.
+func DiffBy[T, Key any](first, second iter.Seq[T], keySelector func(T) Key, equal func(T, T) bool) (iter.Seq[Change[Key, T]], error) {
+	if first == nil {
+		return nil, newOperatorError("DiffBy", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("DiffBy", "second", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("DiffBy", "keySelector", ErrNilSelector)
+	}
+	if equal == nil {
+		return nil, newOperatorError("DiffBy", "equal", ErrNilEqual)
+	}
+	lk1, _ := ToLookup(first, keySelector)
+	lk2, _ := ToLookup(second, keySelector)
+	for _, lk := range []*Lookup[Key, T]{lk1, lk2} {
+		for g := range lk.Groupings() {
+			if len(g.values) > 1 {
+				return nil, newOperatorError("DiffBy", "", &DuplicateKeyError[Key]{Key: g.key})
+			}
+		}
+	}
+	var changes []Change[Key, T]
+	for g := range lk1.Groupings() {
+		old := g.values[0]
+		nn := lk2.itemSlice(g.key)
+		if len(nn) == 0 {
+			changes = append(changes, Change[Key, T]{Kind: ChangeRemoved, Key: g.key, Old: old})
+		} else if !equal(old, nn[0]) {
+			changes = append(changes, Change[Key, T]{Kind: ChangeModified, Key: g.key, Old: old, New: nn[0]})
+		}
+	}
+	for g := range lk2.Groupings() {
+		if !lk1.Contains(g.key) {
+			changes = append(changes, Change[Key, T]{Kind: ChangeAdded, Key: g.key, New: g.values[0]})
+		}
+	}
+	return SliceAll(changes), nil
+}
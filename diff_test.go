@@ -0,0 +1,224 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// lcsLength returns the length of the longest common subsequence of 'a' and 'b'.
+func lcsLength(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else {
+				dp[i][j] = max(dp[i+1][j], dp[i][j+1])
+			}
+		}
+	}
+	return dp[0][0]
+}
+
+// checkEdits verifies that 'edits' transforms 'a' into 'b' and that indices are consistent.
+func checkEdits(t *testing.T, a, b []int, edits []Edit[int]) {
+	t.Helper()
+	var gotA, gotB []int
+	for _, e := range edits {
+		switch e.Kind {
+		case EditKeep:
+			if e.FirstIndex != len(gotA) || e.SecondIndex != len(gotB) {
+				t.Fatalf("wrong indices of %+v", e)
+			}
+			gotA, gotB = append(gotA, e.Value), append(gotB, e.Value)
+		case EditDelete:
+			if e.FirstIndex != len(gotA) || e.SecondIndex != -1 {
+				t.Fatalf("wrong indices of %+v", e)
+			}
+			gotA = append(gotA, e.Value)
+		case EditInsert:
+			if e.FirstIndex != -1 || e.SecondIndex != len(gotB) {
+				t.Fatalf("wrong indices of %+v", e)
+			}
+			gotB = append(gotB, e.Value)
+		}
+	}
+	if !slices.Equal(gotA, a) || !slices.Equal(gotB, b) {
+		t.Fatalf("edits %v transform %v into %v, want %v into %v", edits, gotA, gotB, a, b)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	equal := func(x, y int) bool { return x == y }
+	if _, err := Diff(nil, VarAll(1), equal); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Diff() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := Diff(VarAll(1), nil, equal); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Diff() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := Diff(VarAll(1), VarAll(1), nil); !errors.Is(err, ErrNilEqual) {
+		t.Errorf("Diff() error = %v, expectedErr %v", err, ErrNilEqual)
+	}
+	tests := []struct {
+		name          string
+		first, second iter.Seq[int]
+		want          string
+	}{
+		{name: "BothEmpty", first: Empty[int](), second: Empty[int](), want: ""},
+		{name: "FirstEmpty", first: Empty[int](), second: VarAll(1, 2), want: "+1 +2"},
+		{name: "SecondEmpty", first: VarAll(1, 2), second: Empty[int](), want: "-1 -2"},
+		{name: "Equal", first: VarAll(1, 2, 3), second: VarAll(1, 2, 3), want: " 1  2  3"},
+		{name: "Replace", first: VarAll(1, 2, 3), second: VarAll(1, 4, 3), want: " 1 -2 +4  3"},
+		{name: "Myers", first: VarAll(1, 2, 3, 1, 2, 2, 1), second: VarAll(3, 2, 1, 2, 1, 3),
+			want: "-1 -2  3 +2  1  2 -2  1 +3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := Diff(tt.first, tt.second, equal)
+			if s := StringFmt(got, " ", "", "", "", ""); s != tt.want {
+				t.Errorf("Diff() = %q, want %q", s, tt.want)
+			}
+			checkEdits(t, ToSliceMust(tt.first), ToSliceMust(tt.second), ToSliceMust(got))
+		})
+	}
+}
+
+func TestDiff_minimal(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 300 {
+		a := make([]int, rng.IntN(15))
+		for i := range a {
+			a[i] = rng.IntN(4)
+		}
+		b := make([]int, rng.IntN(15))
+		for i := range b {
+			b[i] = rng.IntN(4)
+		}
+		got, _ := Diff(SliceAll(a), SliceAll(b), func(x, y int) bool { return x == y })
+		edits := ToSliceMust(got)
+		checkEdits(t, a, b, edits)
+		changes := 0
+		for _, e := range edits {
+			if e.Kind != EditKeep {
+				changes++
+			}
+		}
+		if want := len(a) + len(b) - 2*lcsLength(a, b); changes != want {
+			t.Fatalf("Diff(%v, %v) has %d changes, want %d", a, b, changes, want)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(s string) iter.Seq[string] { return SliceAll(strings.Split(s, " ")) }
+	tests := []struct {
+		name          string
+		first, second string
+		context       int
+		want          string
+	}{
+		{name: "NoChanges", first: "a b c", second: "a b c", context: 3, want: ""},
+		{name: "OneHunk", first: "a b c d e", second: "a b x d e", context: 1,
+			want: "@@ -2,3 +2,3 @@\n b\n-c\n+x\n d\n"},
+		{name: "TwoHunks", first: "a b c d e f g h", second: "x b c d e f g y", context: 1,
+			want: "@@ -1,2 +1,2 @@\n-a\n+x\n b\n@@ -7,2 +7,2 @@\n g\n-h\n+y\n"},
+		{name: "MergedHunks", first: "a b c d e", second: "x b c d y", context: 2,
+			want: "@@ -1,5 +1,5 @@\n-a\n+x\n b\n c\n d\n-e\n+y\n"},
+		{name: "ZeroContext", first: "a b c", second: "a c", context: 0,
+			want: "@@ -2 +1,0 @@\n-b\n"},
+		{name: "Insert", first: "a", second: "a b", context: 0,
+			want: "@@ -1,0 +2 @@\n+b\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edits, _ := Diff(lines(tt.first), lines(tt.second), func(x, y string) bool { return x == y })
+			if got := UnifiedDiff(edits, tt.context); got != tt.want {
+				t.Errorf("UnifiedDiff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type account struct {
+	ID      int
+	Balance int
+}
+
+func TestDiffBy(t *testing.T) {
+	id := func(a account) int { return a.ID }
+	equal := func(a1, a2 account) bool { return a1 == a2 }
+	if _, err := DiffBy[account, int](nil, VarAll(account{}), id, equal); !errors.Is(err, ErrNilSource) {
+		t.Errorf("DiffBy() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := DiffBy[account, int](VarAll(account{}), VarAll(account{}), nil, equal); !errors.Is(err, ErrNilSelector) {
+		t.Errorf("DiffBy() error = %v, expectedErr %v", err, ErrNilSelector)
+	}
+	if _, err := DiffBy(VarAll(account{}), VarAll(account{}), id, nil); !errors.Is(err, ErrNilEqual) {
+		t.Errorf("DiffBy() error = %v, expectedErr %v", err, ErrNilEqual)
+	}
+	_, err := DiffBy(VarAll(account{1, 10}), VarAll(account{2, 20}, account{2, 30}), id, equal)
+	var dke *DuplicateKeyError[int]
+	if !errors.As(err, &dke) || dke.Key != 2 {
+		t.Errorf("DiffBy() error = %v, want DuplicateKeyError for key 2", err)
+	}
+	got, _ := DiffBy(
+		VarAll(account{1, 100}, account{2, 200}, account{3, 300}),
+		VarAll(account{4, 400}, account{3, 350}, account{1, 100}),
+		id, equal)
+	want := "[-2: {2 200} ~3: {3 300} -> {3 350} +4: {4 400}]"
+	if s := StringDef(got); s != want {
+		t.Errorf("DiffBy() = %v, want %v", s, want)
+	}
+}
+
+func ExampleDiff() {
+	before := SliceAll(strings.Split("the quick brown fox jumps", " "))
+	after := SliceAll(strings.Split("the quick red fox leaps", " "))
+	edits, _ := Diff(before, after, func(s1, s2 string) bool { return s1 == s2 })
+	for e := range edits {
+		fmt.Printf("%-6v %2d %2d %s\n", e.Kind, e.FirstIndex, e.SecondIndex, e.Value)
+	}
+	fmt.Print(UnifiedDiff(edits, 1))
+	// Output:
+	// keep    0  0 the
+	// keep    1  1 quick
+	// delete  2 -1 brown
+	// insert -1  2 red
+	// keep    3  3 fox
+	// delete  4 -1 jumps
+	// insert -1  4 leaps
+	// @@ -2,4 +2,4 @@
+	//  quick
+	// -brown
+	// +red
+	//  fox
+	// -jumps
+	// +leaps
+}
+
+func ExampleDiffBy() {
+	type price struct {
+		sku   string
+		cents int
+	}
+	yesterday := VarAll(price{"apple", 50}, price{"pear", 70}, price{"plum", 30})
+	today := VarAll(price{"apple", 55}, price{"plum", 30}, price{"kiwi", 40})
+	changes, _ := DiffBy(yesterday, today,
+		func(p price) string { return p.sku },
+		func(p1, p2 price) bool { return p1 == p2 })
+	for c := range changes {
+		fmt.Println(c.Kind, c.Key)
+	}
+	// Output:
+	// modified apple
+	// removed pear
+	// added kiwi
+}
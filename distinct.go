@@ -2,19 +2,18 @@ package go2linq
 
 import (
 	"iter"
-
-	"github.com/solsw/generichelper"
 )
 
 // [Distinct] returns distinct elements from a sequence using [generichelper.DeepEqual] to compare values.
+// Already seen elements are kept in hash buckets (see [DeepHash]).
 // Order of elements in the result corresponds to the order of elements in 'source'.
 //
 // [Distinct]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.distinct
 func Distinct[Source any](source iter.Seq[Source]) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("Distinct", "source", ErrNilSource)
 	}
-	return DistinctEq(source, generichelper.DeepEqual[Source])
+	return DistinctComparer(source, DeepComparer[Source]())
 }
 
 // [DistinctEq] returns distinct elements from a sequence using a specified 'equal' to compare values.
@@ -22,6 +21,12 @@ func Distinct[Source any](source iter.Seq[Source]) (iter.Seq[Source], error) {
 //
 // [DistinctEq]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.distinct
 func DistinctEq[Source any](source iter.Seq[Source], equal func(Source, Source) bool) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("DistinctEq", "source", ErrNilSource)
+	}
+	if equal == nil {
+		return nil, newOperatorError("DistinctEq", "equal", ErrNilEqual)
+	}
 	return DistinctByEq(source, Identity[Source], equal)
 }
 
@@ -35,5 +40,33 @@ func DistinctEq[Source any](source iter.Seq[Source], equal func(Source, Source)
 //
 // [DistinctCmp]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.distinct
 func DistinctCmp[Source any](source iter.Seq[Source], compare func(Source, Source) int) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("DistinctCmp", "source", ErrNilSource)
+	}
+	if compare == nil {
+		return nil, newOperatorError("DistinctCmp", "compare", ErrNilCompare)
+	}
 	return DistinctByCmp(source, Identity[Source], compare)
 }
+
+// DistinctComparer returns distinct elements from a sequence using a specified 'comparer' to compare values.
+// Order of elements in the result corresponds to the order of elements in 'source'.
+//
+// Already seen elements are kept in hash buckets, so only elements with equal hash codes are compared.
+func DistinctComparer[Source any](source iter.Seq[Source], comparer EqualityComparer[Source]) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("DistinctComparer", "source", ErrNilSource)
+	}
+	if comparer == nil {
+		return nil, newOperatorError("DistinctComparer", "comparer", ErrNilComparer)
+	}
+	return func(yield func(Source) bool) {
+			seen, _ := NewHashSet(comparer.Equal, comparer.Hash)
+			for s := range source {
+				if seen.Add(s) && !yield(s) {
+					return
+				}
+			}
+		},
+		nil
+}
@@ -2,6 +2,7 @@ package go2linq
 
 import (
 	"cmp"
+	"errors"
 	"fmt"
 	"iter"
 	"math/rand"
@@ -47,7 +48,7 @@ func TestDistinct_int(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("Distinct() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -145,7 +146,7 @@ func TestDistinctEq_string(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("DistinctEq() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -309,3 +310,21 @@ func ExampleDistinctEq() {
 	// orange 4
 	// lemon 12
 }
+
+func TestDistinctComparer(t *testing.T) {
+	if _, err := DistinctComparer(nil, CaseInsensitiveComparer); !errors.Is(err, ErrNilSource) {
+		t.Errorf("DistinctComparer() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := DistinctComparer(VarAll("a"), nil); !errors.Is(err, ErrNilComparer) {
+		t.Errorf("DistinctComparer() error = %v, expectedErr %v", err, ErrNilComparer)
+	}
+	got, _ := DistinctComparer(VarAll("xyz", "TestString", "XYZ", "Def", "Teststring"), CaseInsensitiveComparer)
+	want := VarAll("xyz", "TestString", "Def")
+	if equal, _ := SequenceEqual(got, want); !equal {
+		t.Errorf("DistinctComparer() = %v, want %v", StringDef(got), StringDef(want))
+	}
+	// the result may be enumerated more than once
+	if equal, _ := SequenceEqual(got, want); !equal {
+		t.Errorf("DistinctComparer() second enumeration = %v, want %v", StringDef(got), StringDef(want))
+	}
+}
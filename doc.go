@@ -8,3 +8,5 @@
 // [iter.Seq]: https://go.dev/wiki/RangefuncExperiment
 // [LINQ to Objects]: https://learn.microsoft.com/dotnet/csharp/programming-guide/concepts/linq/linq-to-objects
 package go2linq
+
+//go:generate go run ./internal/mustgen
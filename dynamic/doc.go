@@ -0,0 +1,26 @@
+// Package dynamic implements string based queries over sequences
+// in the spirit of .NET's [System.Linq.Dynamic].
+//
+// Expressions are evaluated with reflection against the elements of [iter.Seq].
+// Members of the element are referenced by their (exported) field names,
+// nested members are referenced using dot notation (e.g. 'Address.City').
+// The element itself may be referenced using 'it' keyword.
+//
+// Supported expression elements:
+//   - literals: integers, floats, strings ("..." or '...'), true, false, null;
+//   - logical operators: ||, or, &&, and, !, not;
+//   - comparison operators: ==, =, !=, <>, <, <=, >, >=;
+//   - arithmetic operators: +, -, *, /, % ('+' also concatenates strings).
+//   - string members: Length, StartsWith(s), EndsWith(s), Contains(s), ToLower(), ToUpper(), Trim().
+//
+// Nil pointers in member paths evaluate to [zero value] of the member.
+//
+// Arithmetic on unsigned integers is performed in uint64, on other integers (and on mixed operands) in int64.
+// Integer division or remainder by a constant zero expression is reported as [*ParseError].
+// If a divisor evaluates to zero for an element, or an unsigned operand mixed with a signed one
+// does not fit in int64, the evaluation panics with [*EvalError].
+//
+// [System.Linq.Dynamic]: https://dynamic-linq.net/
+// [iter.Seq]: https://go.dev/wiki/RangefuncExperiment
+// [zero value]: https://go.dev/ref/spec#The_zero_value
+package dynamic
@@ -0,0 +1,132 @@
+package dynamic
+
+import (
+	"iter"
+	"reflect"
+
+	"github.com/solsw/go2linq/v4"
+)
+
+// itValue returns [reflect.Value] of 't' with static type 'T'.
+func itValue[T any](t T) reflect.Value {
+	return reflect.ValueOf(&t).Elem()
+}
+
+// Predicate parses a boolean 'expr' (e.g. `Age > 30 && Name.StartsWith("A")`)
+// and returns the corresponding predicate function.
+// If 'expr' cannot be parsed, [*ParseError] is returned.
+// If 'expr' cannot be evaluated for an element, the predicate panics with [*EvalError].
+func Predicate[T any](expr string) (func(T) bool, error) {
+	p, err := newParser(expr, reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	x, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	if !isBool(x.typ) {
+		return nil, p.errorf(x.pos, "expression of type %v is not boolean", x.typ)
+	}
+	return func(t T) bool {
+		return x.eval(itValue(t)).Bool()
+	}, nil
+}
+
+// Where filters a sequence of values based on a boolean 'predicate' expression.
+// (See [go2linq.Where] and [Predicate].)
+func Where[T any](source iter.Seq[T], predicate string) (iter.Seq[T], error) {
+	if source == nil {
+		return nil, &go2linq.OperatorError{Op: "dynamic.Where", Arg: "source", Err: go2linq.ErrNilSource}
+	}
+	pred, err := Predicate[T](predicate)
+	if err != nil {
+		return nil, err
+	}
+	return go2linq.Where(source, pred)
+}
+
+// OrderBy sorts the elements of a sequence according to an 'ordering' (e.g. "Dept, Salary desc").
+// 'ordering' is a comma-separated list of expressions of numeric, string or boolean type,
+// each of which may be followed by 'asc' (default) or 'desc'.
+// The sort is stable.
+// If an expression cannot be evaluated for an element, the enumeration panics with [*EvalError].
+func OrderBy[T any](source iter.Seq[T], ordering string) (iter.Seq[T], error) {
+	if source == nil {
+		return nil, &go2linq.OperatorError{Op: "dynamic.OrderBy", Arg: "source", Err: go2linq.ErrNilSource}
+	}
+	p, err := newParser(ordering, reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	kk, err := p.parseOrdering()
+	if err != nil {
+		return nil, err
+	}
+	return go2linq.OrderByKeyLs(source,
+		func(t T) []reflect.Value {
+			it := itValue(t)
+			vv := make([]reflect.Value, len(kk))
+			for i, k := range kk {
+				vv[i] = k.key.eval(it)
+			}
+			return vv
+		},
+		func(x, y []reflect.Value) bool {
+			for i, k := range kk {
+				c := k.cmp(x[i], y[i])
+				if c == 0 {
+					continue
+				}
+				if k.desc {
+					return c > 0
+				}
+				return c < 0
+			}
+			return false
+		},
+	)
+}
+
+// Select projects each element of a sequence according to a 'selector' expression.
+//
+// If 'selector' has the form "new(expr1, expr2 as Alias, ...)", each element is projected
+// into a value of a dynamically created struct type.
+// Fields of the struct are named after aliases or, if alias is omitted, after the last member
+// in the member access expression (e.g. 'City' for 'Address.City').
+// Otherwise, each element is projected into the value of the 'selector' expression.
+// If an expression cannot be evaluated for an element, the enumeration panics with [*EvalError].
+func Select[T any](source iter.Seq[T], selector string) (iter.Seq[any], error) {
+	if source == nil {
+		return nil, &go2linq.OperatorError{Op: "dynamic.Select", Arg: "source", Err: go2linq.ErrNilSource}
+	}
+	p, err := newParser(selector, reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	fields, single, err := p.parseProjection()
+	if err != nil {
+		return nil, err
+	}
+	if fields == nil {
+		return go2linq.Select(source, func(t T) any {
+			return single.eval(itValue(t)).Interface()
+		})
+	}
+	sff := make([]reflect.StructField, len(fields))
+	for i, f := range fields {
+		sff[i] = reflect.StructField{Name: f.name, Type: f.val.typ}
+	}
+	st := reflect.StructOf(sff)
+	return go2linq.Select(source, func(t T) any {
+		it := itValue(t)
+		r := reflect.New(st).Elem()
+		for i, f := range fields {
+			r.Field(i).Set(f.val.eval(it))
+		}
+		return r.Interface()
+	})
+}
@@ -0,0 +1,286 @@
+package dynamic
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"testing"
+
+	"github.com/solsw/go2linq/v4"
+)
+
+type (
+	Address struct {
+		City string
+	}
+	Employee struct {
+		Name    string
+		Age     int
+		Dept    string
+		Salary  float64
+		Manager bool
+		Address *Address
+		secret  string
+	}
+)
+
+var employees = []Employee{
+	{Name: "Alice", Age: 34, Dept: "IT", Salary: 5000, Address: &Address{City: "Oslo"}},
+	{Name: "Bob", Age: 28, Dept: "Sales", Salary: 3000, Address: &Address{City: "Rome"}},
+	{Name: "Anna", Age: 45, Dept: "IT", Salary: 7000, Manager: true},
+	{Name: "Carl", Age: 31, Dept: "Sales", Salary: 4500, Address: &Address{City: "Oslo"}},
+	{Name: "Dora", Age: 25, Dept: "IT", Salary: 4000, Address: &Address{City: "Paris"}},
+}
+
+func names(seq iter.Seq[Employee]) string {
+	ss, _ := go2linq.Select(seq, func(e Employee) string { return e.Name })
+	return go2linq.StringDef(ss)
+}
+
+func TestWhere(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate string
+		want      string
+	}{
+		{name: "Comparison", predicate: `Age > 30`, want: "[Alice Anna Carl]"},
+		{name: "And", predicate: `Age > 30 && Name.StartsWith("A")`, want: "[Alice Anna]"},
+		{name: "AndKeyword", predicate: `Age > 30 and Name.StartsWith('A')`, want: "[Alice Anna]"},
+		{name: "Or", predicate: `Dept == "Sales" || Manager`, want: "[Bob Anna Carl]"},
+		{name: "Not", predicate: `!(Dept = "IT")`, want: "[Bob Carl]"},
+		{name: "Arithmetic", predicate: `Salary / Age > 158`, want: "[Dora]"},
+		{name: "Modulo", predicate: `Age % 2 == 0`, want: "[Alice Bob]"},
+		{name: "Nested", predicate: `Address.City == "Oslo"`, want: "[Alice Carl]"},
+		{name: "NilNested", predicate: `Address.City == ""`, want: "[Anna]"},
+		{name: "Null", predicate: `Address == null`, want: "[Anna]"},
+		{name: "StringMembers", predicate: `Name.ToLower().Contains("a") && Name.Length <= 4`, want: "[Anna Carl Dora]"},
+		{name: "Negative", predicate: `-Age < -40`, want: "[Anna]"},
+		{name: "Concat", predicate: `Name + Dept == "BobSales"`, want: "[Bob]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Where(go2linq.SliceAll(employees), tt.predicate)
+			if err != nil {
+				t.Fatalf("Where() error = %v", err)
+			}
+			if s := names(got); s != tt.want {
+				t.Errorf("Where() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestWhere_it(t *testing.T) {
+	got, _ := Where(go2linq.VarAll(1, 2, 3, 4, 5, 6), "it % 2 == 0 or it == 1")
+	want := go2linq.VarAll(1, 2, 4, 6)
+	if equal, _ := go2linq.SequenceEqual(got, want); !equal {
+		t.Errorf("Where() = %v, want %v", go2linq.StringDef(got), go2linq.StringDef(want))
+	}
+}
+
+func TestWhere_zeroDivisor(t *testing.T) {
+	got, _ := Where(go2linq.VarAll(1, 3, 5), "10 % it == 0")
+	if equal, _ := go2linq.SequenceEqual(got, go2linq.VarAll(1, 5)); !equal {
+		t.Errorf("Where() = %v, want [1 5]", go2linq.StringDef(got))
+	}
+	for _, predicate := range []string{"10 / it == 0", "10 % (it - 3) == 0"} {
+		t.Run(predicate, func(t *testing.T) {
+			got, err := Where(go2linq.VarAll(1, 0, 3), predicate)
+			if err != nil {
+				t.Fatalf("Where() error = %v", err)
+			}
+			defer func() {
+				ee, ok := recover().(*EvalError)
+				if !ok || !errors.Is(ee, ErrDivisionByZero) {
+					t.Errorf("Where() panic = %v, want *EvalError with %v", ee, ErrDivisionByZero)
+				}
+			}()
+			for range got {
+			}
+			t.Errorf("Where() did not panic")
+		})
+	}
+}
+
+func TestWhere_unsigned(t *testing.T) {
+	type item struct {
+		U uint64
+		I int
+	}
+	items := go2linq.VarAll(item{U: math.MaxUint64, I: -1}, item{U: 1 << 63, I: 2}, item{U: 4, I: 2})
+	tests := []struct {
+		predicate string
+		want      string
+	}{
+		{predicate: "U > I", want: "[{18446744073709551615 -1} {9223372036854775808 2} {4 2}]"},
+		{predicate: "U / 2 > 9223372036854775806", want: "[{18446744073709551615 -1}]"},
+		{predicate: "U % 4 == 0", want: "[{9223372036854775808 2} {4 2}]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.predicate, func(t *testing.T) {
+			got, err := Where(items, tt.predicate)
+			if err != nil {
+				t.Fatalf("Where() error = %v", err)
+			}
+			if s := go2linq.StringDef(got); s != tt.want {
+				t.Errorf("Where() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+	// a huge unsigned divisor mixed with a signed operand must not wrap
+	got, _ := Where(items, "I / U == 0")
+	defer func() {
+		ee, ok := recover().(*EvalError)
+		if !ok || !errors.Is(ee, go2linq.ErrOverflow) {
+			t.Errorf("Where() panic = %v, want *EvalError with %v", ee, go2linq.ErrOverflow)
+		}
+	}()
+	for range got {
+	}
+	t.Errorf("Where() did not panic")
+}
+
+func TestWhere_error(t *testing.T) {
+	if _, err := Where[Employee](nil, "Age > 1"); !errors.Is(err, go2linq.ErrNilSource) {
+		t.Errorf("Where() error = %v, expectedErr %v", err, go2linq.ErrNilSource)
+	}
+	tests := []struct {
+		predicate string
+		pos       int
+	}{
+		{predicate: `Agee > 30`, pos: 0},
+		{predicate: `Age > 30 && secret == ""`, pos: 12},
+		{predicate: `Age > "30"`, pos: 4},
+		{predicate: `Age + 1`, pos: 0},
+		{predicate: `Name.StartsWith(1)`, pos: 5},
+		{predicate: `Name.Foo()`, pos: 5},
+		{predicate: `Age > 30 )`, pos: 9},
+		{predicate: `Age > `, pos: 6},
+		{predicate: `Name == "abc`, pos: 8},
+		{predicate: `Age # 3`, pos: 4},
+		{predicate: `Address.Street == ""`, pos: 8},
+		{predicate: `Manager < true`, pos: 8},
+		{predicate: `Age / 0 > 1`, pos: 4},
+		{predicate: `Age % (-0) == 1`, pos: 4},
+		{predicate: `Age / (2-2) > 1`, pos: 4},
+		{predicate: `Age % (1*0) == 1`, pos: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.predicate, func(t *testing.T) {
+			_, err := Where(go2linq.SliceAll(employees), tt.predicate)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("Where() error = %v, want *ParseError", err)
+			}
+			if pe.Pos != tt.pos {
+				t.Errorf("Where() error position = %d, want %d (%v)", pe.Pos, tt.pos, err)
+			}
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		ordering string
+		want     string
+	}{
+		{name: "Single", ordering: "Age", want: "[Dora Bob Carl Alice Anna]"},
+		{name: "Desc", ordering: "Salary desc", want: "[Anna Alice Carl Dora Bob]"},
+		{name: "Multiple", ordering: "Dept, Salary descending", want: "[Anna Alice Dora Carl Bob]"},
+		{name: "Expression", ordering: "Name.Length, Name DESC", want: "[Bob Dora Carl Anna Alice]"},
+		{name: "Stable", ordering: "Address.City", want: "[Anna Alice Carl Dora Bob]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OrderBy(go2linq.SliceAll(employees), tt.ordering)
+			if err != nil {
+				t.Fatalf("OrderBy() error = %v", err)
+			}
+			if s := names(got); s != tt.want {
+				t.Errorf("OrderBy() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderBy_error(t *testing.T) {
+	for _, ordering := range []string{"Address", "Age up", "Age,", ""} {
+		t.Run(ordering, func(t *testing.T) {
+			_, err := OrderBy(go2linq.SliceAll(employees), ordering)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("OrderBy() error = %v, want *ParseError", err)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		want     string
+	}{
+		{name: "Single", selector: "Name", want: "[Alice Bob Anna Carl Dora]"},
+		{name: "Expression", selector: "Age * 2", want: "[68 56 90 62 50]"},
+		{name: "New", selector: "new(Name, Age)", want: "[{Alice 34} {Bob 28} {Anna 45} {Carl 31} {Dora 25}]"},
+		{name: "NewAlias", selector: "new(Name.ToUpper() as Upper, Address.City)",
+			want: "[{ALICE Oslo} {BOB Rome} {ANNA } {CARL Oslo} {DORA Paris}]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Select(go2linq.SliceAll(employees), tt.selector)
+			if err != nil {
+				t.Fatalf("Select() error = %v", err)
+			}
+			if s := go2linq.StringDef(got); s != tt.want {
+				t.Errorf("Select() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelect_error(t *testing.T) {
+	for _, selector := range []string{"new(Age + 1)", "new(Name, Name)", "new(Age as age)", "null", "new(Name"} {
+		t.Run(selector, func(t *testing.T) {
+			_, err := Select(go2linq.SliceAll(employees), selector)
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Errorf("Select() error = %v, want *ParseError", err)
+			}
+		})
+	}
+}
+
+func ExampleWhere() {
+	where, _ := Where(go2linq.SliceAll(employees), `Age > 30 && Name.StartsWith("A")`)
+	for e := range where {
+		fmt.Println(e.Name)
+	}
+	// Output:
+	// Alice
+	// Anna
+}
+
+func ExampleOrderBy() {
+	orderBy, _ := OrderBy(go2linq.SliceAll(employees), "Dept, Salary desc")
+	sel, _ := Select(orderBy, "new(Dept, Name, Salary)")
+	for e := range sel {
+		fmt.Println(e)
+	}
+	// Output:
+	// {IT Anna 7000}
+	// {IT Alice 5000}
+	// {IT Dora 4000}
+	// {Sales Carl 4500}
+	// {Sales Bob 3000}
+}
+
+func ExampleParseError() {
+	_, err := Where(go2linq.SliceAll(employees), `Age > 30 && Nmae == "Bob"`)
+	fmt.Println(err)
+	// Output:
+	// type dynamic.Employee has no field Nmae (at position 12 in "Age > 30 && Nmae == \"Bob\"")
+}
@@ -0,0 +1,51 @@
+package dynamic
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDivisionByZero is wrapped by [*EvalError] when an integer is divided by zero.
+var ErrDivisionByZero = errors.New("integer division by zero")
+
+// ParseError describes a problem encountered while parsing an expression.
+type ParseError struct {
+	// Expr is the expression being parsed.
+	Expr string
+	// Pos is the byte offset in Expr where the problem was detected.
+	Pos int
+	// Msg describes the problem.
+	Msg string
+}
+
+func newParseError(expr string, pos int, format string, args ...any) *ParseError {
+	return &ParseError{Expr: expr, Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Error implements the [error] interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s (at position %d in %q)", e.Msg, e.Pos, e.Expr)
+}
+
+// EvalError describes a problem encountered while evaluating a parsed expression for an element.
+// Functions returned by this package panic with *EvalError, since they have no error result.
+// Err is [ErrDivisionByZero] for an integer division or remainder by zero
+// and [go2linq.ErrOverflow] for an unsigned value that does not fit in int64.
+type EvalError struct {
+	// Expr is the evaluated expression.
+	Expr string
+	// Pos is the byte offset in Expr of the operator that failed.
+	Pos int
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the [error] interface.
+func (e *EvalError) Error() string {
+	return fmt.Sprintf("%v (at position %d in %q)", e.Err, e.Pos, e.Expr)
+}
+
+// Unwrap returns the underlying error.
+func (e *EvalError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,151 @@
+package dynamic
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokInt
+	tokFloat
+	tokString
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	// text is the token's source text (unquoted for tokString)
+	text string
+	// pos is the byte offset of the token in the expression
+	pos int
+}
+
+// is determines whether 't' is an operator or a keyword equal to 's'.
+// Keywords are compared case-insensitively.
+func (t token) is(s string) bool {
+	switch t.kind {
+	case tokOp:
+		return t.text == s
+	case tokIdent:
+		return strings.EqualFold(t.text, s)
+	}
+	return false
+}
+
+var twoCharOps = []string{"&&", "||", "==", "!=", "<=", ">=", "<>"}
+
+const oneCharOps = "!<>=+-*/%().,"
+
+// lex splits 'expr' into tokens.
+func lex(expr string) ([]token, error) {
+	var tt []token
+	i := 0
+	for i < len(expr) {
+		r, w := utf8.DecodeRuneInString(expr[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += w
+		case r == '_' || unicode.IsLetter(r):
+			start := i
+			for i < len(expr) {
+				r, w := utf8.DecodeRuneInString(expr[i:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += w
+			}
+			tt = append(tt, token{kind: tokIdent, text: expr[start:i], pos: start})
+		case '0' <= r && r <= '9':
+			t, err := lexNumber(expr, i)
+			if err != nil {
+				return nil, err
+			}
+			tt = append(tt, t)
+			i += len(t.text)
+		case r == '"' || r == '\'':
+			t, n, err := lexString(expr, i)
+			if err != nil {
+				return nil, err
+			}
+			tt = append(tt, t)
+			i += n
+		default:
+			if i+1 < len(expr) {
+				two := expr[i : i+2]
+				if slices.Contains(twoCharOps, two) {
+					tt = append(tt, token{kind: tokOp, text: two, pos: i})
+					i += 2
+					continue
+				}
+			}
+			if !strings.ContainsRune(oneCharOps, r) {
+				return nil, newParseError(expr, i, "unexpected character %q", r)
+			}
+			tt = append(tt, token{kind: tokOp, text: string(r), pos: i})
+			i += w
+		}
+	}
+	tt = append(tt, token{kind: tokEOF, pos: len(expr)})
+	return tt, nil
+}
+
+func lexNumber(expr string, start int) (token, error) {
+	i := start
+	digits := func() {
+		for i < len(expr) && '0' <= expr[i] && expr[i] <= '9' {
+			i++
+		}
+	}
+	digits()
+	kind := tokInt
+	if i+1 < len(expr) && expr[i] == '.' && '0' <= expr[i+1] && expr[i+1] <= '9' {
+		kind = tokFloat
+		i++
+		digits()
+	}
+	if i < len(expr) && (expr[i] == 'e' || expr[i] == 'E') {
+		kind = tokFloat
+		i++
+		if i < len(expr) && (expr[i] == '+' || expr[i] == '-') {
+			i++
+		}
+		if i == len(expr) || expr[i] < '0' || '9' < expr[i] {
+			return token{}, newParseError(expr, start, "malformed number")
+		}
+		digits()
+	}
+	return token{kind: kind, text: expr[start:i], pos: start}, nil
+}
+
+// lexString returns string token starting at 'start' and the length of its source text.
+// Double-quoted strings are unquoted according to Go rules, single-quoted strings are taken verbatim.
+func lexString(expr string, start int) (token, int, error) {
+	quote := expr[start]
+	i := start + 1
+	for i < len(expr) && expr[i] != quote {
+		if quote == '"' && expr[i] == '\\' {
+			i++
+		}
+		i++
+	}
+	if i >= len(expr) {
+		return token{}, 0, newParseError(expr, start, "unterminated string")
+	}
+	raw := expr[start : i+1]
+	text := raw[1 : len(raw)-1]
+	if quote == '"' {
+		var err error
+		text, err = strconv.Unquote(raw)
+		if err != nil {
+			return token{}, 0, newParseError(expr, start, "malformed string")
+		}
+	}
+	return token{kind: tokString, text: text, pos: start}, len(raw), nil
+}
@@ -0,0 +1,782 @@
+package dynamic
+
+import (
+	"cmp"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/solsw/go2linq/v4"
+)
+
+var (
+	boolType    = reflect.TypeFor[bool]()
+	intType     = reflect.TypeFor[int]()
+	int64Type   = reflect.TypeFor[int64]()
+	uint64Type  = reflect.TypeFor[uint64]()
+	float64Type = reflect.TypeFor[float64]()
+	stringType  = reflect.TypeFor[string]()
+)
+
+// node is a compiled expression.
+type node struct {
+	// typ is the static type of the expression's value, nil for 'null' literal
+	typ reflect.Type
+	pos int
+	// name is the name of the last member for member access expressions
+	name string
+	// eval evaluates the expression for the element 'it'
+	eval func(it reflect.Value) reflect.Value
+	// constant reports whether the expression's value does not depend on the element
+	constant bool
+}
+
+func constNode(v reflect.Value, pos int) node {
+	return node{typ: v.Type(), pos: pos, eval: func(reflect.Value) reflect.Value { return v }, constant: true}
+}
+
+func isBool(t reflect.Type) bool {
+	return t != nil && t.Kind() == reflect.Bool
+}
+
+func isInt(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isUint(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isFloat(t reflect.Type) bool {
+	return t != nil && (t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64)
+}
+
+func isNumber(t reflect.Type) bool {
+	return isInt(t) || isFloat(t)
+}
+
+func isString(t reflect.Type) bool {
+	return t != nil && t.Kind() == reflect.String
+}
+
+func isExported(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
+
+func isNillable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	}
+	return false
+}
+
+// toInt converts the integer 'v' to int64.
+// toInt returns false if 'v' is unsigned and does not fit in int64.
+func toInt(v reflect.Value) (int64, bool) {
+	if isUint(v.Type()) {
+		u := v.Uint()
+		return int64(u), u <= math.MaxInt64
+	}
+	return v.Int(), true
+}
+
+// toUint converts the unsigned or non-negative integer 'v' to uint64.
+func toUint(v reflect.Value) uint64 {
+	if isUint(v.Type()) {
+		return v.Uint()
+	}
+	return uint64(v.Int())
+}
+
+// compareInts compares integers 'x' and 'y' of any signedness.
+func compareInts(x, y reflect.Value) int {
+	xu, yu := isUint(x.Type()), isUint(y.Type())
+	switch {
+	case xu && yu:
+		return cmp.Compare(x.Uint(), y.Uint())
+	case xu:
+		if y.Int() < 0 {
+			return 1
+		}
+		return cmp.Compare(x.Uint(), uint64(y.Int()))
+	case yu:
+		return -compareInts(y, x)
+	}
+	return cmp.Compare(x.Int(), y.Int())
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint())
+	}
+	return float64(v.Int())
+}
+
+// deref dereferences pointers in 'v', nil pointer results in zero value of the pointed type.
+func deref(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Zero(v.Type().Elem())
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// compareValues compares values of the orderable static types 'tx' and 'ty'.
+func compareValues(tx, ty reflect.Type) func(x, y reflect.Value) int {
+	switch {
+	case isInt(tx) && isInt(ty):
+		return compareInts
+	case isNumber(tx) && isNumber(ty):
+		return func(x, y reflect.Value) int { return cmp.Compare(toFloat(x), toFloat(y)) }
+	case isString(tx) && isString(ty):
+		return func(x, y reflect.Value) int { return cmp.Compare(x.String(), y.String()) }
+	case isBool(tx) && isBool(ty):
+		return func(x, y reflect.Value) int {
+			switch {
+			case x.Bool() == y.Bool():
+				return 0
+			case y.Bool():
+				return -1
+			}
+			return 1
+		}
+	}
+	return nil
+}
+
+type parser struct {
+	expr string
+	toks []token
+	i    int
+	// root is the type of the element
+	root reflect.Type
+}
+
+func newParser(expr string, root reflect.Type) (*parser, error) {
+	toks, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &parser{expr: expr, toks: toks, root: root}, nil
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) errorf(pos int, format string, args ...any) *ParseError {
+	return newParseError(p.expr, pos, format, args...)
+}
+
+// fail panics with [*EvalError] for the operator at 'pos'.
+func (p *parser) fail(pos int, err error) {
+	panic(&EvalError{Expr: p.expr, Pos: pos, Err: err})
+}
+
+// toInt is like the package level toInt but panics with [*EvalError] on overflow.
+func (p *parser) toInt(pos int, v reflect.Value) int64 {
+	i, ok := toInt(v)
+	if !ok {
+		p.fail(pos, go2linq.ErrOverflow)
+	}
+	return i
+}
+
+func (p *parser) expect(op string) error {
+	t := p.next()
+	if !t.is(op) {
+		return p.unexpected(t, op)
+	}
+	return nil
+}
+
+func (p *parser) unexpected(t token, want string) *ParseError {
+	if t.kind == tokEOF {
+		return p.errorf(t.pos, "unexpected end of expression, expected %s", want)
+	}
+	return p.errorf(t.pos, "unexpected %q, expected %s", t.text, want)
+}
+
+func (p *parser) expectEOF() error {
+	if t := p.peek(); t.kind != tokEOF {
+		return p.errorf(t.pos, "unexpected %q", t.text)
+	}
+	return nil
+}
+
+// parseExpr parses: or
+func (p *parser) parseExpr() (node, error) {
+	return p.parseOr()
+}
+
+// parseOr parses: and {('||' | 'or') and}
+func (p *parser) parseOr() (node, error) {
+	return p.parseLogical(p.parseAnd, "||", "or", func(x, y func(reflect.Value) reflect.Value) func(reflect.Value) reflect.Value {
+		return func(it reflect.Value) reflect.Value {
+			return reflect.ValueOf(x(it).Bool() || y(it).Bool())
+		}
+	})
+}
+
+// parseAnd parses: comparison {('&&' | 'and') comparison}
+func (p *parser) parseAnd() (node, error) {
+	return p.parseLogical(p.parseComparison, "&&", "and", func(x, y func(reflect.Value) reflect.Value) func(reflect.Value) reflect.Value {
+		return func(it reflect.Value) reflect.Value {
+			return reflect.ValueOf(x(it).Bool() && y(it).Bool())
+		}
+	})
+}
+
+func (p *parser) parseLogical(operand func() (node, error), op, keyword string,
+	combine func(x, y func(reflect.Value) reflect.Value) func(reflect.Value) reflect.Value) (node, error) {
+	l, err := operand()
+	if err != nil {
+		return node{}, err
+	}
+	for t := p.peek(); t.is(op) || (t.kind == tokIdent && t.is(keyword)); t = p.peek() {
+		p.next()
+		r, err := operand()
+		if err != nil {
+			return node{}, err
+		}
+		if !isBool(l.typ) || !isBool(r.typ) {
+			return node{}, p.errorf(t.pos, "operator %s not defined on %v and %v", t.text, l.typ, r.typ)
+		}
+		l = node{typ: boolType, pos: l.pos, eval: combine(l.eval, r.eval), constant: l.constant && r.constant}
+	}
+	return l, nil
+}
+
+var comparisonOps = []string{"==", "=", "!=", "<>", "<", "<=", ">", ">="}
+
+// parseComparison parses: additive [comparisonOp additive]
+func (p *parser) parseComparison() (node, error) {
+	l, err := p.parseAdditive()
+	if err != nil {
+		return node{}, err
+	}
+	t := p.peek()
+	op := ""
+	for _, o := range comparisonOps {
+		if t.is(o) {
+			op = o
+			break
+		}
+	}
+	if op == "" {
+		return l, nil
+	}
+	p.next()
+	r, err := p.parseAdditive()
+	if err != nil {
+		return node{}, err
+	}
+	eq := op == "==" || op == "=" || op == "!=" || op == "<>"
+	var c func(x, y reflect.Value) int
+	switch {
+	case l.typ == nil || r.typ == nil:
+		other := l
+		if other.typ == nil {
+			other = r
+		}
+		if !eq || (other.typ != nil && !isNillable(other.typ)) {
+			return node{}, p.errorf(t.pos, "operator %s not defined on %v and %v", op, l.typ, r.typ)
+		}
+		isNil := func(v reflect.Value) bool { return !v.IsValid() || v.IsNil() }
+		c = func(x, y reflect.Value) int {
+			if isNil(x) == isNil(y) {
+				return 0
+			}
+			return 1
+		}
+	default:
+		c = compareValues(l.typ, r.typ)
+		if c != nil && isBool(l.typ) && !eq {
+			c = nil
+		}
+		if c == nil && eq && l.typ == r.typ && l.typ.Comparable() {
+			c = func(x, y reflect.Value) int {
+				if x.Equal(y) {
+					return 0
+				}
+				return 1
+			}
+		}
+		if c == nil {
+			return node{}, p.errorf(t.pos, "operator %s not defined on %v and %v", op, l.typ, r.typ)
+		}
+	}
+	var test func(int) bool
+	switch op {
+	case "==", "=":
+		test = func(i int) bool { return i == 0 }
+	case "!=", "<>":
+		test = func(i int) bool { return i != 0 }
+	case "<":
+		test = func(i int) bool { return i < 0 }
+	case "<=":
+		test = func(i int) bool { return i <= 0 }
+	case ">":
+		test = func(i int) bool { return i > 0 }
+	case ">=":
+		test = func(i int) bool { return i >= 0 }
+	}
+	return node{typ: boolType, pos: l.pos,
+		eval: func(it reflect.Value) reflect.Value {
+			return reflect.ValueOf(test(c(l.eval(it), r.eval(it))))
+		},
+		constant: l.constant && r.constant,
+	}, nil
+}
+
+// parseAdditive parses: multiplicative {('+' | '-') multiplicative}
+func (p *parser) parseAdditive() (node, error) {
+	return p.parseArithmetic(p.parseMultiplicative, "+", "-")
+}
+
+// parseMultiplicative parses: unary {('*' | '/' | '%') unary}
+func (p *parser) parseMultiplicative() (node, error) {
+	return p.parseArithmetic(p.parseUnary, "*", "/", "%")
+}
+
+func (p *parser) parseArithmetic(operand func() (node, error), ops ...string) (node, error) {
+	l, err := operand()
+	if err != nil {
+		return node{}, err
+	}
+	for {
+		t := p.peek()
+		op := ""
+		for _, o := range ops {
+			if t.is(o) {
+				op = o
+				break
+			}
+		}
+		if op == "" {
+			return l, nil
+		}
+		p.next()
+		r, err := operand()
+		if err != nil {
+			return node{}, err
+		}
+		l, err = p.arithmetic(t, op, l, r)
+		if err != nil {
+			return node{}, err
+		}
+	}
+}
+
+func (p *parser) arithmetic(t token, op string, l, r node) (node, error) {
+	le, re := l.eval, r.eval
+	constant := l.constant && r.constant
+	switch {
+	case op == "+" && isString(l.typ) && isString(r.typ):
+		return node{typ: stringType, pos: l.pos, eval: func(it reflect.Value) reflect.Value {
+			return reflect.ValueOf(le(it).String() + re(it).String())
+		}, constant: constant}, nil
+	case isInt(l.typ) && isInt(r.typ):
+		div := op == "/" || op == "%"
+		if div && r.constant && re(reflect.Value{}).IsZero() {
+			return node{}, p.errorf(t.pos, "integer division by zero")
+		}
+		// a non-negative constant is converted to the type of the unsigned operand (as untyped constants in Go)
+		unsigned := func(x node) bool {
+			return isUint(x.typ) || (x.constant && x.eval(reflect.Value{}).Int() >= 0)
+		}
+		if (isUint(l.typ) || isUint(r.typ)) && unsigned(l) && unsigned(r) {
+			var f func(x, y uint64) uint64
+			switch op {
+			case "+":
+				f = func(x, y uint64) uint64 { return x + y }
+			case "-":
+				f = func(x, y uint64) uint64 { return x - y }
+			case "*":
+				f = func(x, y uint64) uint64 { return x * y }
+			case "/":
+				f = func(x, y uint64) uint64 { return x / y }
+			case "%":
+				f = func(x, y uint64) uint64 { return x % y }
+			}
+			return node{typ: uint64Type, pos: l.pos, eval: func(it reflect.Value) reflect.Value {
+				x, y := toUint(le(it)), toUint(re(it))
+				if div && y == 0 {
+					p.fail(t.pos, ErrDivisionByZero)
+				}
+				return reflect.ValueOf(f(x, y))
+			}, constant: constant}, nil
+		}
+		var f func(x, y int64) int64
+		switch op {
+		case "+":
+			f = func(x, y int64) int64 { return x + y }
+		case "-":
+			f = func(x, y int64) int64 { return x - y }
+		case "*":
+			f = func(x, y int64) int64 { return x * y }
+		case "/":
+			f = func(x, y int64) int64 { return x / y }
+		case "%":
+			f = func(x, y int64) int64 { return x % y }
+		}
+		return node{typ: int64Type, pos: l.pos, eval: func(it reflect.Value) reflect.Value {
+			x, y := p.toInt(t.pos, le(it)), p.toInt(t.pos, re(it))
+			if div && y == 0 {
+				p.fail(t.pos, ErrDivisionByZero)
+			}
+			return reflect.ValueOf(f(x, y))
+		}, constant: constant}, nil
+	case isNumber(l.typ) && isNumber(r.typ) && op != "%":
+		var f func(x, y float64) float64
+		switch op {
+		case "+":
+			f = func(x, y float64) float64 { return x + y }
+		case "-":
+			f = func(x, y float64) float64 { return x - y }
+		case "*":
+			f = func(x, y float64) float64 { return x * y }
+		case "/":
+			f = func(x, y float64) float64 { return x / y }
+		}
+		return node{typ: float64Type, pos: l.pos, eval: func(it reflect.Value) reflect.Value {
+			return reflect.ValueOf(f(toFloat(le(it)), toFloat(re(it))))
+		}, constant: constant}, nil
+	}
+	return node{}, p.errorf(t.pos, "operator %s not defined on %v and %v", op, l.typ, r.typ)
+}
+
+// parseUnary parses: ('!' | 'not' | '-') unary | postfix
+func (p *parser) parseUnary() (node, error) {
+	t := p.peek()
+	switch {
+	case t.is("!") || (t.kind == tokIdent && t.is("not")):
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return node{}, err
+		}
+		if !isBool(x.typ) {
+			return node{}, p.errorf(t.pos, "operator %s not defined on %v", t.text, x.typ)
+		}
+		return node{typ: boolType, pos: t.pos, eval: func(it reflect.Value) reflect.Value {
+			return reflect.ValueOf(!x.eval(it).Bool())
+		}, constant: x.constant}, nil
+	case t.is("-"):
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return node{}, err
+		}
+		switch {
+		case isInt(x.typ):
+			return node{typ: int64Type, pos: t.pos, eval: func(it reflect.Value) reflect.Value {
+				return reflect.ValueOf(-p.toInt(t.pos, x.eval(it)))
+			}, constant: x.constant}, nil
+		case isFloat(x.typ):
+			return node{typ: float64Type, pos: t.pos, eval: func(it reflect.Value) reflect.Value {
+				return reflect.ValueOf(-toFloat(x.eval(it)))
+			}, constant: x.constant}, nil
+		}
+		return node{}, p.errorf(t.pos, "operator - not defined on %v", x.typ)
+	}
+	return p.parsePostfix()
+}
+
+// parsePostfix parses: primary {'.' identifier ['(' [expr {',' expr}] ')']}
+func (p *parser) parsePostfix() (node, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return node{}, err
+	}
+	for p.peek().is(".") {
+		p.next()
+		t := p.next()
+		if t.kind != tokIdent {
+			return node{}, p.unexpected(t, "member name")
+		}
+		if p.peek().is("(") {
+			p.next()
+			var args []node
+			for !p.peek().is(")") {
+				if len(args) > 0 {
+					if err := p.expect(","); err != nil {
+						return node{}, err
+					}
+				}
+				a, err := p.parseExpr()
+				if err != nil {
+					return node{}, err
+				}
+				args = append(args, a)
+			}
+			p.next()
+			x, err = p.method(x, t, args)
+		} else {
+			x, err = p.member(x, t)
+		}
+		if err != nil {
+			return node{}, err
+		}
+	}
+	return x, nil
+}
+
+// parsePrimary parses: literal | 'it' | identifier | '(' expr ')'
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokInt:
+		i, err := strconv.ParseInt(t.text, 10, 64)
+		if err != nil {
+			return node{}, p.errorf(t.pos, "malformed integer %s", t.text)
+		}
+		return constNode(reflect.ValueOf(i), t.pos), nil
+	case tokFloat:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return node{}, p.errorf(t.pos, "malformed float %s", t.text)
+		}
+		return constNode(reflect.ValueOf(f), t.pos), nil
+	case tokString:
+		return constNode(reflect.ValueOf(t.text), t.pos), nil
+	case tokIdent:
+		switch {
+		case t.is("true"):
+			return constNode(reflect.ValueOf(true), t.pos), nil
+		case t.is("false"):
+			return constNode(reflect.ValueOf(false), t.pos), nil
+		case t.is("null") || t.is("nil"):
+			return node{pos: t.pos, eval: func(reflect.Value) reflect.Value { return reflect.Value{} }}, nil
+		case t.is("it"):
+			return node{typ: p.root, pos: t.pos, eval: func(it reflect.Value) reflect.Value { return it }}, nil
+		}
+		it := node{typ: p.root, pos: t.pos, eval: func(it reflect.Value) reflect.Value { return it }}
+		return p.member(it, t)
+	case tokOp:
+		if t.is("(") {
+			x, err := p.parseExpr()
+			if err != nil {
+				return node{}, err
+			}
+			if err := p.expect(")"); err != nil {
+				return node{}, err
+			}
+			return x, nil
+		}
+	}
+	return node{}, p.unexpected(t, "operand")
+}
+
+// member compiles access to member 't' of 'x'.
+func (p *parser) member(x node, t token) (node, error) {
+	name := t.text
+	if x.typ == nil {
+		return node{}, p.errorf(t.pos, "null has no member %s", name)
+	}
+	base := x.typ
+	for base.Kind() == reflect.Pointer {
+		base = base.Elem()
+	}
+	xe := x.eval
+	if isString(base) && name == "Length" {
+		return node{typ: intType, pos: x.pos, name: name, eval: func(it reflect.Value) reflect.Value {
+			return reflect.ValueOf(len(deref(xe(it)).String()))
+		}}, nil
+	}
+	if base.Kind() != reflect.Struct {
+		return node{}, p.errorf(t.pos, "type %v has no member %s", x.typ, name)
+	}
+	sf, ok := base.FieldByName(name)
+	if !ok {
+		return node{}, p.errorf(t.pos, "type %v has no field %s", base, name)
+	}
+	if !sf.IsExported() {
+		return node{}, p.errorf(t.pos, "field %s of type %v is unexported", name, base)
+	}
+	return node{typ: sf.Type, pos: x.pos, name: name, eval: func(it reflect.Value) reflect.Value {
+		v, err := deref(xe(it)).FieldByIndexErr(sf.Index)
+		if err != nil {
+			// nil embedded pointer
+			return reflect.Zero(sf.Type)
+		}
+		return v
+	}}, nil
+}
+
+// method compiles call of method 't' of 'x' with arguments 'args'.
+func (p *parser) method(x node, t token, args []node) (node, error) {
+	name := t.text
+	if !isString(x.typ) {
+		return node{}, p.errorf(t.pos, "type %v has no method %s", x.typ, name)
+	}
+	xe := x.eval
+	var unary func(string) string
+	var binary func(string, string) bool
+	switch name {
+	case "ToLower":
+		unary = strings.ToLower
+	case "ToUpper":
+		unary = strings.ToUpper
+	case "Trim":
+		unary = strings.TrimSpace
+	case "StartsWith":
+		binary = strings.HasPrefix
+	case "EndsWith":
+		binary = strings.HasSuffix
+	case "Contains":
+		binary = strings.Contains
+	default:
+		return node{}, p.errorf(t.pos, "type %v has no method %s", x.typ, name)
+	}
+	if unary != nil {
+		if len(args) != 0 {
+			return node{}, p.errorf(t.pos, "method %s takes no arguments", name)
+		}
+		return node{typ: stringType, pos: x.pos, eval: func(it reflect.Value) reflect.Value {
+			return reflect.ValueOf(unary(xe(it).String()))
+		}}, nil
+	}
+	if len(args) != 1 || !isString(args[0].typ) {
+		return node{}, p.errorf(t.pos, "method %s takes one string argument", name)
+	}
+	ae := args[0].eval
+	return node{typ: boolType, pos: x.pos, eval: func(it reflect.Value) reflect.Value {
+		return reflect.ValueOf(binary(xe(it).String(), ae(it).String()))
+	}}, nil
+}
+
+// orderingKey is a compiled element of ordering list.
+type orderingKey struct {
+	key  node
+	desc bool
+	cmp  func(x, y reflect.Value) int
+}
+
+// parseOrdering parses: expr ['asc' | 'ascending' | 'desc' | 'descending'] {',' ...}
+func (p *parser) parseOrdering() ([]orderingKey, error) {
+	var kk []orderingKey
+	for {
+		x, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		c := compareValues(x.typ, x.typ)
+		if c == nil {
+			return nil, p.errorf(x.pos, "type %v is not orderable", x.typ)
+		}
+		k := orderingKey{key: x, cmp: c}
+		switch t := p.peek(); {
+		case t.kind == tokIdent && (t.is("desc") || t.is("descending")):
+			k.desc = true
+			p.next()
+		case t.kind == tokIdent && (t.is("asc") || t.is("ascending")):
+			p.next()
+		}
+		kk = append(kk, k)
+		if !p.peek().is(",") {
+			break
+		}
+		p.next()
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, err
+	}
+	return kk, nil
+}
+
+// projectionField is a compiled element of 'new(...)' projection.
+type projectionField struct {
+	name string
+	val  node
+}
+
+// parseProjection parses: 'new' '(' expr ['as' identifier] {',' ...} ')' | expr
+// If the result is a single expression, 'fields' is nil.
+func (p *parser) parseProjection() (fields []projectionField, single node, err error) {
+	if t := p.peek(); t.kind == tokIdent && t.is("new") && p.toks[p.i+1].is("(") {
+		p.next()
+		p.next()
+		seen := make(map[string]bool)
+		for {
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, node{}, err
+			}
+			if x.typ == nil {
+				return nil, node{}, p.errorf(x.pos, "null cannot be projected")
+			}
+			name, pos := x.name, x.pos
+			if p.peek().kind == tokIdent && p.peek().is("as") {
+				p.next()
+				t := p.next()
+				if t.kind != tokIdent {
+					return nil, node{}, p.unexpected(t, "alias")
+				}
+				name, pos = t.text, t.pos
+			}
+			switch {
+			case name == "":
+				return nil, node{}, p.errorf(x.pos, "alias required for projected expression")
+			case !isExported(name):
+				return nil, node{}, p.errorf(pos, "alias %s is not exported", name)
+			case seen[name]:
+				return nil, node{}, p.errorf(pos, "duplicate projected field %s", name)
+			}
+			seen[name] = true
+			fields = append(fields, projectionField{name: name, val: x})
+			if !p.peek().is(",") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, node{}, err
+		}
+	} else {
+		single, err = p.parseExpr()
+		if err != nil {
+			return nil, node{}, err
+		}
+		if single.typ == nil {
+			return nil, node{}, p.errorf(single.pos, "null cannot be projected")
+		}
+	}
+	if err := p.expectEOF(); err != nil {
+		return nil, node{}, err
+	}
+	return fields, single, nil
+}
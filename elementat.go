@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"iter"
 
 	"github.com/solsw/generichelper"
@@ -11,10 +12,10 @@ import (
 // [ElementAt]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.elementat
 func ElementAt[Source any](source iter.Seq[Source], index int) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("ElementAt", "source", ErrNilSource)
 	}
 	if index < 0 {
-		return generichelper.ZeroValue[Source](), ErrIndexOutOfRange
+		return generichelper.ZeroValue[Source](), newOperatorError("ElementAt", "index", &IndexError{Index: index, Length: -1})
 	}
 	i := 0
 	for s := range source {
@@ -23,7 +24,7 @@ func ElementAt[Source any](source iter.Seq[Source], index int) (Source, error) {
 		}
 		i++
 	}
-	return generichelper.ZeroValue[Source](), ErrIndexOutOfRange
+	return generichelper.ZeroValue[Source](), newOperatorError("ElementAt", "index", &IndexError{Index: index, Length: i})
 }
 
 // [ElementAtOrDefault] returns the element at a specified index in a sequence or a [zero value] if the index is out of range.
@@ -32,10 +33,10 @@ func ElementAt[Source any](source iter.Seq[Source], index int) (Source, error) {
 // [zero value]: https://go.dev/ref/spec#The_zero_value
 func ElementAtOrDefault[Source any](source iter.Seq[Source], index int) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("ElementAtOrDefault", "source", ErrNilSource)
 	}
 	s, err := ElementAt(source, index)
-	if err == ErrIndexOutOfRange {
+	if errors.Is(err, ErrIndexOutOfRange) {
 		return generichelper.ZeroValue[Source](), nil
 	}
 	return s, nil
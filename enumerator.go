@@ -0,0 +1,197 @@
+package go2linq
+
+import (
+	"iter"
+	"runtime"
+)
+
+// [Enumerator] supports a simple imperative iteration over a sequence.
+// Enumerator is built on top of [iter.Pull].
+//
+// Enumerator must be closed (see [Enumerator.Close]) when it is no longer needed.
+// If it becomes unreachable without being closed, the underlying [iter.Pull] is stopped by a finalizer.
+//
+// [Enumerator]: https://learn.microsoft.com/dotnet/api/system.collections.generic.ienumerator-1
+type Enumerator[T any] struct {
+	next    func() (T, bool)
+	stop    func()
+	current T
+	// pushed is a stack of elements pushed back or peeked
+	pushed []T
+	closed bool
+}
+
+// NewEnumerator returns an [Enumerator] over 'seq'.
+func NewEnumerator[T any](seq iter.Seq[T]) (*Enumerator[T], error) {
+	if seq == nil {
+		return nil, newOperatorError("NewEnumerator", "seq", ErrNilSource)
+	}
+	next, stop := iter.Pull(seq)
+	en := &Enumerator[T]{next: next, stop: stop}
+	runtime.SetFinalizer(en, func(en *Enumerator[T]) { en.stop() })
+	return en, nil
+}
+
+// [MoveNext] advances the enumerator to the next element of the sequence.
+// MoveNext returns false if the enumerator has passed the end of the sequence or is closed.
+//
+// [MoveNext]: https://learn.microsoft.com/dotnet/api/system.collections.ienumerator.movenext
+func (en *Enumerator[T]) MoveNext() bool {
+	if en.closed {
+		return false
+	}
+	if n := len(en.pushed); n > 0 {
+		en.current = en.pushed[n-1]
+		en.pushed = en.pushed[:n-1]
+		return true
+	}
+	t, ok := en.next()
+	if !ok {
+		return false
+	}
+	en.current = t
+	return true
+}
+
+// [Current] gets the element at the current position of the enumerator.
+//
+// [Current]: https://learn.microsoft.com/dotnet/api/system.collections.generic.ienumerator-1.current
+func (en *Enumerator[T]) Current() T {
+	return en.current
+}
+
+// Peek returns the element that the next call to [Enumerator.MoveNext] will make current
+// without advancing the enumerator.
+// Peek returns false if there is no such element.
+func (en *Enumerator[T]) Peek() (T, bool) {
+	if en.closed {
+		var t0 T
+		return t0, false
+	}
+	if n := len(en.pushed); n > 0 {
+		return en.pushed[n-1], true
+	}
+	t, ok := en.next()
+	if !ok {
+		return t, false
+	}
+	en.pushed = append(en.pushed, t)
+	return t, true
+}
+
+// PushBack pushes 't' back to the enumerator,
+// so that the next call to [Enumerator.MoveNext] makes 't' current.
+// Several pushed back elements are returned in reverse order.
+// PushBack on closed enumerator does nothing.
+func (en *Enumerator[T]) PushBack(t T) {
+	if en.closed {
+		return
+	}
+	en.pushed = append(en.pushed, t)
+}
+
+// Close stops the enumeration and releases resources held by the enumerator.
+// Close may be called several times.
+func (en *Enumerator[T]) Close() {
+	if en.closed {
+		return
+	}
+	en.closed = true
+	en.pushed = nil
+	en.stop()
+	runtime.SetFinalizer(en, nil)
+}
+
+// Enumerator2 is an [Enumerator] counterpart for sequence2.
+type Enumerator2[K, V any] struct {
+	next     func() (K, V, bool)
+	stop     func()
+	currentK K
+	currentV V
+	// pushed is a stack of pairs pushed back or peeked
+	pushed []elel2[K, V]
+	closed bool
+}
+
+type elel2[K, V any] struct {
+	k K
+	v V
+}
+
+// NewEnumerator2 returns an [Enumerator2] over 'seq2'.
+func NewEnumerator2[K, V any](seq2 iter.Seq2[K, V]) (*Enumerator2[K, V], error) {
+	if seq2 == nil {
+		return nil, newOperatorError("NewEnumerator2", "seq2", ErrNilSource)
+	}
+	next, stop := iter.Pull2(seq2)
+	en := &Enumerator2[K, V]{next: next, stop: stop}
+	runtime.SetFinalizer(en, func(en *Enumerator2[K, V]) { en.stop() })
+	return en, nil
+}
+
+// MoveNext advances the enumerator to the next pair of the sequence2.
+// MoveNext returns false if the enumerator has passed the end of the sequence2 or is closed.
+func (en *Enumerator2[K, V]) MoveNext() bool {
+	if en.closed {
+		return false
+	}
+	if n := len(en.pushed); n > 0 {
+		en.currentK, en.currentV = en.pushed[n-1].k, en.pushed[n-1].v
+		en.pushed = en.pushed[:n-1]
+		return true
+	}
+	k, v, ok := en.next()
+	if !ok {
+		return false
+	}
+	en.currentK, en.currentV = k, v
+	return true
+}
+
+// Current gets the pair at the current position of the enumerator.
+func (en *Enumerator2[K, V]) Current() (K, V) {
+	return en.currentK, en.currentV
+}
+
+// Peek returns the pair that the next call to [Enumerator2.MoveNext] will make current
+// without advancing the enumerator.
+// Peek returns false if there is no such pair.
+func (en *Enumerator2[K, V]) Peek() (K, V, bool) {
+	if en.closed {
+		var k0 K
+		var v0 V
+		return k0, v0, false
+	}
+	if n := len(en.pushed); n > 0 {
+		return en.pushed[n-1].k, en.pushed[n-1].v, true
+	}
+	k, v, ok := en.next()
+	if !ok {
+		return k, v, false
+	}
+	en.pushed = append(en.pushed, elel2[K, V]{k: k, v: v})
+	return k, v, true
+}
+
+// PushBack pushes the pair back to the enumerator,
+// so that the next call to [Enumerator2.MoveNext] makes the pair current.
+// Several pushed back pairs are returned in reverse order.
+// PushBack on closed enumerator does nothing.
+func (en *Enumerator2[K, V]) PushBack(k K, v V) {
+	if en.closed {
+		return
+	}
+	en.pushed = append(en.pushed, elel2[K, V]{k: k, v: v})
+}
+
+// Close stops the enumeration and releases resources held by the enumerator.
+// Close may be called several times.
+func (en *Enumerator2[K, V]) Close() {
+	if en.closed {
+		return
+	}
+	en.closed = true
+	en.pushed = nil
+	en.stop()
+	runtime.SetFinalizer(en, nil)
+}
@@ -0,0 +1,192 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stopTracker returns a sequence that sets 'stopped' when its enumeration is finished.
+func stopTracker(n int, stopped *atomic.Bool) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		defer stopped.Store(true)
+		for i := range n {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestNewEnumerator(t *testing.T) {
+	_, err := NewEnumerator[int](nil)
+	if !errors.Is(err, ErrNilSource) {
+		t.Errorf("NewEnumerator() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	_, err = NewEnumerator2[int, string](nil)
+	if !errors.Is(err, ErrNilSource) {
+		t.Errorf("NewEnumerator2() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+}
+
+func TestEnumerator_MoveNext(t *testing.T) {
+	tests := []struct {
+		name string
+		seq  iter.Seq[int]
+		want []int
+	}{
+		{name: "Empty",
+			seq:  Empty[int](),
+			want: nil,
+		},
+		{name: "1",
+			seq:  VarAll(1),
+			want: []int{1},
+		},
+		{name: "3",
+			seq:  VarAll(1, 2, 3),
+			want: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			en, _ := NewEnumerator(tt.seq)
+			defer en.Close()
+			var got []int
+			for en.MoveNext() {
+				got = append(got, en.Current())
+			}
+			equal, _ := SequenceEqual(SliceAll(got), SliceAll(tt.want))
+			if !equal {
+				t.Errorf("MoveNext() = %v, want %v", got, tt.want)
+			}
+			if en.MoveNext() {
+				t.Errorf("MoveNext() after end = true, want false")
+			}
+		})
+	}
+}
+
+func TestEnumerator_PeekPushBack(t *testing.T) {
+	en, _ := NewEnumerator(VarAll(1, 2, 3))
+	defer en.Close()
+	if p, ok := en.Peek(); !ok || p != 1 {
+		t.Errorf("Peek() = %v, %v, want 1, true", p, ok)
+	}
+	// repeated Peek does not advance
+	if p, ok := en.Peek(); !ok || p != 1 {
+		t.Errorf("Peek() = %v, %v, want 1, true", p, ok)
+	}
+	en.MoveNext()
+	if c := en.Current(); c != 1 {
+		t.Errorf("Current() = %v, want 1", c)
+	}
+	en.PushBack(10)
+	en.PushBack(20)
+	var got []int
+	for en.MoveNext() {
+		got = append(got, en.Current())
+	}
+	want := []int{20, 10, 2, 3}
+	equal, _ := SequenceEqual(SliceAll(got), SliceAll(want))
+	if !equal {
+		t.Errorf("MoveNext() = %v, want %v", got, want)
+	}
+	if _, ok := en.Peek(); ok {
+		t.Errorf("Peek() at end = true, want false")
+	}
+}
+
+func TestEnumerator_Close(t *testing.T) {
+	var stopped atomic.Bool
+	en, _ := NewEnumerator(stopTracker(10, &stopped))
+	en.MoveNext()
+	en.PushBack(100)
+	en.Close()
+	if !stopped.Load() {
+		t.Errorf("Close() did not stop the source")
+	}
+	if en.MoveNext() {
+		t.Errorf("MoveNext() after Close() = true, want false")
+	}
+	if _, ok := en.Peek(); ok {
+		t.Errorf("Peek() after Close() = true, want false")
+	}
+	// second Close is a no-op
+	en.Close()
+}
+
+func TestEnumerator_leak(t *testing.T) {
+	var stopped atomic.Bool
+	func() {
+		en, _ := NewEnumerator(stopTracker(10, &stopped))
+		en.MoveNext()
+	}()
+	for range 50 {
+		runtime.GC()
+		if stopped.Load() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("unclosed Enumerator was not stopped by finalizer")
+}
+
+func TestEnumerator2(t *testing.T) {
+	var stopped atomic.Bool
+	seq2 := func(yield func(int, string) bool) {
+		defer stopped.Store(true)
+		for i, s := range SliceAll2([]string{"a", "b", "c"}) {
+			if !yield(i, s) {
+				return
+			}
+		}
+	}
+	en, _ := NewEnumerator2(seq2)
+	if k, v, ok := en.Peek(); !ok || k != 0 || v != "a" {
+		t.Errorf("Peek() = %v, %v, %v, want 0, a, true", k, v, ok)
+	}
+	en.MoveNext()
+	en.PushBack(-1, "z")
+	var got []string
+	for en.MoveNext() {
+		k, v := en.Current()
+		got = append(got, fmt.Sprintf("%d:%s", k, v))
+	}
+	want := []string{"-1:z", "1:b", "2:c"}
+	equal, _ := SequenceEqual(SliceAll(got), SliceAll(want))
+	if !equal {
+		t.Errorf("MoveNext() = %v, want %v", got, want)
+	}
+	en.Close()
+	if !stopped.Load() {
+		t.Errorf("Close() did not stop the source")
+	}
+}
+
+func ExampleEnumerator() {
+	// sums runs of equal adjacent numbers
+	en, _ := NewEnumerator(VarAll(1, 1, 2, 3, 3, 3, 1))
+	defer en.Close()
+	for en.MoveNext() {
+		n, sum := en.Current(), en.Current()
+		for {
+			next, ok := en.Peek()
+			if !ok || next != n {
+				break
+			}
+			en.MoveNext()
+			sum += en.Current()
+		}
+		fmt.Println(sum)
+	}
+	// Output:
+	// 2
+	// 2
+	// 9
+	// 1
+}
@@ -0,0 +1,189 @@
+package go2linq
+
+import (
+	"cmp"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// [EqualityComparer] defines methods to support the comparison of values for equality.
+//
+// Hash must return equal hash codes for values that are equal according to Equal.
+// Operators that accept EqualityComparer (e.g. [DistinctComparer], [GroupByComparer], [JoinComparer])
+// distribute values into buckets by hash code and compare with Equal only values from the same bucket.
+//
+// [EqualityComparer]: https://learn.microsoft.com/dotnet/api/system.collections.generic.iequalitycomparer-1
+type EqualityComparer[T any] interface {
+	// Equal determines whether the specified values are equal.
+	Equal(T, T) bool
+	// Hash returns a hash code for the specified value.
+	Hash(T) uint64
+}
+
+// hashSeed is used by the built-in comparers' hash functions.
+var hashSeed = maphash.MakeSeed()
+
+// combineHash mixes hash code 'h2' into hash code 'h1'.
+func combineHash(h1, h2 uint64) uint64 {
+	// splitmix64 finalizer
+	x := h1*0x9e3779b97f4a7c15 + h2
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// ComparerFunc is an adapter to allow the use of ordinary functions as [EqualityComparer].
+type ComparerFunc[T any] struct {
+	EqualFunc func(T, T) bool
+	HashFunc  func(T) uint64
+}
+
+// Equal implements the [EqualityComparer] interface.
+func (c ComparerFunc[T]) Equal(x, y T) bool {
+	return c.EqualFunc(x, y)
+}
+
+// Hash implements the [EqualityComparer] interface.
+func (c ComparerFunc[T]) Hash(t T) uint64 {
+	return c.HashFunc(t)
+}
+
+type orderedComparer[T cmp.Ordered] struct{}
+
+// OrderedComparer returns an [EqualityComparer] for [cmp.Ordered] types that uses == to compare values.
+// As with ==, NaN is not equal to itself, and 0.0 is equal to -0.0.
+func OrderedComparer[T cmp.Ordered]() EqualityComparer[T] {
+	return orderedComparer[T]{}
+}
+
+func (orderedComparer[T]) Equal(x, y T) bool {
+	return x == y
+}
+
+func (orderedComparer[T]) Hash(t T) uint64 {
+	v := reflect.ValueOf(t)
+	switch v.Kind() {
+	case reflect.String:
+		return maphash.String(hashSeed, v.String())
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f == 0 {
+			// 0.0 and -0.0 are equal
+			f = 0
+		}
+		return combineHash(0, math.Float64bits(f))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return combineHash(0, uint64(v.Int()))
+	}
+	return combineHash(0, v.Uint())
+}
+
+type caseInsensitiveComparer struct{}
+
+// CaseInsensitiveComparer is an [EqualityComparer] for strings that compares them under
+// simple Unicode case-folding (see [strings.EqualFold]).
+var CaseInsensitiveComparer EqualityComparer[string] = caseInsensitiveComparer{}
+
+func (caseInsensitiveComparer) Equal(x, y string) bool {
+	return strings.EqualFold(x, y)
+}
+
+// foldRune returns the least rune in the case-folding orbit of 'r' (see [unicode.SimpleFold]),
+// so runes equal under simple case-folding have the same foldRune.
+func foldRune(r rune) rune {
+	least := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		least = min(least, f)
+	}
+	return least
+}
+
+func (caseInsensitiveComparer) Hash(s string) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	var buf [utf8.UTFMax]byte
+	for _, r := range s {
+		n := utf8.EncodeRune(buf[:], foldRune(r))
+		h.Write(buf[:n])
+	}
+	return h.Sum64()
+}
+
+type floatToleranceComparer struct {
+	tolerance float64
+}
+
+// FloatToleranceComparer returns an [EqualityComparer] that considers float64 values equal
+// if they differ by no more than 'tolerance'.
+//
+// Since equality within tolerance is not transitive, no hash function can separate such values
+// into buckets, so Hash returns the same hash code for all values
+// and operators fall back to comparing each pair of values.
+func FloatToleranceComparer(tolerance float64) EqualityComparer[float64] {
+	return floatToleranceComparer{tolerance: math.Abs(tolerance)}
+}
+
+func (c floatToleranceComparer) Equal(x, y float64) bool {
+	return x == y || math.Abs(x-y) <= c.tolerance
+}
+
+func (floatToleranceComparer) Hash(float64) uint64 {
+	return 0
+}
+
+type keyComparer[T, Key any] struct {
+	key      func(T) Key
+	comparer EqualityComparer[Key]
+}
+
+// KeyComparer returns an [EqualityComparer] that compares values by keys
+// obtained with 'key' using 'comparer'.
+func KeyComparer[T, Key any](key func(T) Key, comparer EqualityComparer[Key]) EqualityComparer[T] {
+	return keyComparer[T, Key]{key: key, comparer: comparer}
+}
+
+func (c keyComparer[T, Key]) Equal(x, y T) bool {
+	return c.comparer.Equal(c.key(x), c.key(y))
+}
+
+func (c keyComparer[T, Key]) Hash(t T) uint64 {
+	return c.comparer.Hash(c.key(t))
+}
+
+type compositeComparer[T any] []EqualityComparer[T]
+
+// CompositeComparer returns an [EqualityComparer] that considers values equal
+// if they are equal according to each of 'comparers'.
+// Together with [KeyComparer] it allows to compare values by composite keys:
+//
+//	CompositeComparer(
+//		KeyComparer(func(p Person) string { return p.Name }, CaseInsensitiveComparer),
+//		KeyComparer(func(p Person) int { return p.Age }, OrderedComparer[int]()),
+//	)
+func CompositeComparer[T any](comparers ...EqualityComparer[T]) EqualityComparer[T] {
+	return compositeComparer[T](comparers)
+}
+
+func (cc compositeComparer[T]) Equal(x, y T) bool {
+	for _, c := range cc {
+		if !c.Equal(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+func (cc compositeComparer[T]) Hash(t T) uint64 {
+	var h uint64
+	for _, c := range cc {
+		h = combineHash(h, c.Hash(t))
+	}
+	return h
+}
@@ -0,0 +1,110 @@
+package go2linq
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestOrderedComparer(t *testing.T) {
+	ci := OrderedComparer[int]()
+	if !ci.Equal(5, 5) || ci.Equal(5, 6) || ci.Hash(5) != ci.Hash(5) || ci.Hash(5) == ci.Hash(6) {
+		t.Errorf("OrderedComparer[int]() failed")
+	}
+	cf := OrderedComparer[float64]()
+	negZero := math.Copysign(0, -1)
+	if !cf.Equal(0, negZero) || cf.Hash(0) != cf.Hash(negZero) {
+		t.Errorf("OrderedComparer[float64]() failed for 0.0 and -0.0")
+	}
+	if cf.Equal(math.NaN(), math.NaN()) {
+		t.Errorf("OrderedComparer[float64]().Equal(NaN, NaN) = true")
+	}
+	type name string
+	cs := OrderedComparer[name]()
+	if !cs.Equal("a", "a") || cs.Hash("a") != cs.Hash("a") || cs.Hash("a") == cs.Hash("b") {
+		t.Errorf("OrderedComparer[name]() failed")
+	}
+	cu := OrderedComparer[uint8]()
+	if cu.Hash(1) == cu.Hash(2) {
+		t.Errorf("OrderedComparer[uint8]() failed")
+	}
+}
+
+func TestCaseInsensitiveComparer(t *testing.T) {
+	tests := []struct {
+		x, y string
+		want bool
+	}{
+		{x: "Go", y: "GO", want: true},
+		{x: "straße", y: "STRAßE", want: true},
+		{x: "K", y: "k", want: true}, // Kelvin sign
+		{x: "Σίσυφος", y: "ΣΊΣΥΦΟΣ", want: true},
+		{x: "go", y: "gopher", want: false},
+	}
+	c := CaseInsensitiveComparer
+	for _, tt := range tests {
+		if got := c.Equal(tt.x, tt.y); got != tt.want {
+			t.Errorf("Equal(%q, %q) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+		if tt.want && c.Hash(tt.x) != c.Hash(tt.y) {
+			t.Errorf("Hash(%q) != Hash(%q)", tt.x, tt.y)
+		}
+	}
+}
+
+func TestFloatToleranceComparer(t *testing.T) {
+	c := FloatToleranceComparer(-0.01)
+	if !c.Equal(1, 1.005) || c.Equal(1, 1.02) || !c.Equal(math.Inf(1), math.Inf(1)) {
+		t.Errorf("FloatToleranceComparer() failed")
+	}
+	distinct, _ := DistinctComparer(VarAll(1.0, 1.004, 2.0, 1.008, 2.009), c)
+	if got := StringDef(distinct); got != "[1 2]" {
+		t.Errorf("DistinctComparer() = %v, want [1 2]", got)
+	}
+}
+
+func TestCompositeComparer(t *testing.T) {
+	c := CompositeComparer(
+		KeyComparer(func(p Product) string { return p.Name }, CaseInsensitiveComparer),
+		KeyComparer(func(p Product) int { return p.Code }, OrderedComparer[int]()),
+	)
+	apple9, apple9upper, apple4 := Product{Name: "apple", Code: 9}, Product{Name: "APPLE", Code: 9}, Product{Name: "apple", Code: 4}
+	if !c.Equal(apple9, apple9upper) || c.Hash(apple9) != c.Hash(apple9upper) {
+		t.Errorf("CompositeComparer() failed for equal values")
+	}
+	if c.Equal(apple9, apple4) {
+		t.Errorf("CompositeComparer() failed for different values")
+	}
+}
+
+func TestComparerFunc(t *testing.T) {
+	c := ComparerFunc[int]{
+		EqualFunc: func(x, y int) bool { return x%10 == y%10 },
+		HashFunc:  func(x int) uint64 { return uint64(x % 10) },
+	}
+	distinct, _ := DistinctComparer(VarAll(1, 11, 2, 21, 12, 3), c)
+	if got := StringDef(distinct); got != "[1 2 3]" {
+		t.Errorf("DistinctComparer() = %v, want [1 2 3]", got)
+	}
+}
+
+func ExampleCompositeComparer() {
+	products := []Product{
+		{Name: "apple", Code: 9},
+		{Name: "orange", Code: 4},
+		{Name: "Apple", Code: 9},
+		{Name: "apple", Code: 10},
+	}
+	comparer := CompositeComparer(
+		KeyComparer(func(p Product) string { return p.Name }, CaseInsensitiveComparer),
+		KeyComparer(func(p Product) int { return p.Code }, OrderedComparer[int]()),
+	)
+	distinct, _ := DistinctComparer(SliceAll(products), comparer)
+	for p := range distinct {
+		fmt.Println(p.Name, p.Code)
+	}
+	// Output:
+	// apple 9
+	// orange 4
+	// apple 10
+}
@@ -2,24 +2,201 @@ package go2linq
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
 )
 
 var (
 	ErrDuplicateKeys    = errors.New("duplicate keys")
 	ErrEmptySource      = errors.New("empty source")
 	ErrIndexOutOfRange  = errors.New("index out of range")
+	ErrInvalidCast      = errors.New("invalid cast")
 	ErrMultipleElements = errors.New("multiple elements")
 	ErrMultipleMatch    = errors.New("multiple match")
 	ErrNegativeCount    = errors.New("negative count")
 	ErrNilAccumulator   = errors.New("nil accumulator")
 	ErrNilAction        = errors.New("nil action")
+	ErrNilClock         = errors.New("nil clock")
 	ErrNilCompare       = errors.New("nil compare")
+	ErrNilContext       = errors.New("nil context")
+	ErrNilComparer      = errors.New("nil comparer")
 	ErrNilEqual         = errors.New("nil equal")
+	ErrNilHash          = errors.New("nil hash")
 	ErrNilLess          = errors.New("nil less")
+	ErrNilMerge         = errors.New("nil merge")
+	ErrNilNext          = errors.New("nil next")
 	ErrNilPredicate     = errors.New("nil predicate")
 	ErrNilSelector      = errors.New("nil selector")
 	ErrNilSource        = errors.New("nil source")
+	ErrNilSplit         = errors.New("nil split")
+	ErrNilStats         = errors.New("nil stats")
+	ErrNilWriter        = errors.New("nil writer")
 	ErrNoLesses         = errors.New("no lesses")
 	ErrNoMatch          = errors.New("no match")
+	ErrNonPositiveWait  = errors.New("non-positive wait")
+	ErrNotJSONArray     = errors.New("not a JSON array")
+	ErrNotOrderable     = errors.New("not orderable")
+	ErrNotSorted        = errors.New("not sorted")
+	ErrNotStruct        = errors.New("not a struct")
+	ErrOverflow         = errors.New("overflow")
+	ErrPageOutOfRange   = errors.New("page out of range")
 	ErrSizeOutOfRange   = errors.New("size out of range")
+	ErrTruncation       = errors.New("truncation")
+	ErrUnexportedField  = errors.New("unexported field")
+	ErrUnknownField     = errors.New("unknown field")
+	ErrZeroStep         = errors.New("zero step")
 )
+
+// DuplicateKeyError is returned when a key occurs more than once where keys must be unique.
+// DuplicateKeyError wraps [ErrDuplicateKeys].
+type DuplicateKeyError[Key any] struct {
+	// Key is the duplicate key.
+	Key Key
+}
+
+// Error implements the error interface.
+func (e *DuplicateKeyError[Key]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrDuplicateKeys, e.Key)
+}
+
+// Unwrap returns [ErrDuplicateKeys].
+func (e *DuplicateKeyError[Key]) Unwrap() error {
+	return ErrDuplicateKeys
+}
+
+// InvalidCastError is returned when an element of a sequence cannot be cast to a specified type.
+// InvalidCastError wraps [ErrInvalidCast].
+type InvalidCastError struct {
+	// Index is the zero-based index of the element in the sequence.
+	Index int
+	// Type is the dynamic type of the element (nil for nil interface value).
+	Type reflect.Type
+	// Target is the type the element was cast to.
+	Target reflect.Type
+}
+
+// Error implements the error interface.
+func (e *InvalidCastError) Error() string {
+	return fmt.Sprintf("%v: element %d of type %v to %v", ErrInvalidCast, e.Index, e.Type, e.Target)
+}
+
+// Unwrap returns [ErrInvalidCast].
+func (e *InvalidCastError) Unwrap() error {
+	return ErrInvalidCast
+}
+
+// ConversionError is returned when an element of a sequence cannot be converted to a specified type without loss.
+// ConversionError wraps [ErrOverflow] or [ErrTruncation].
+type ConversionError struct {
+	// Index is the zero-based index of the element in the sequence.
+	Index int
+	// Value is the element that cannot be converted.
+	Value any
+	// Target is the type the element was converted to.
+	Target reflect.Type
+	// Err is [ErrOverflow] or [ErrTruncation].
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("%v: element %d (%v) to %v", e.Err, e.Index, e.Value, e.Target)
+}
+
+// Unwrap returns the underlying error.
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// FieldError describes a field path that cannot be resolved or used.
+// FieldError is returned wrapped in [OperatorError] and may be retrieved with [errors.As].
+// FieldError wraps [ErrNotOrderable], [ErrNotStruct], [ErrUnexportedField] or [ErrUnknownField].
+type FieldError struct {
+	// Type is the type the path is resolved against.
+	Type reflect.Type
+	// Path is the field path.
+	Path string
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%v: %q in %v", e.Err, e.Path, e.Type)
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// OperatorError records an error and the operator and the argument that caused it.
+// OperatorError wraps the underlying error, so errors.Is(err, [ErrNilSelector]) and so on work as expected.
+type OperatorError struct {
+	// Op is the name of the operator (e.g. "Where" or "List.Insert").
+	Op string
+	// Arg is the name of the argument that caused the error.
+	// Arg is empty if the error is not caused by a particular argument.
+	Arg string
+	// Err is the underlying error.
+	Err error
+}
+
+// newOperatorError returns [OperatorError] for operator 'op', argument 'arg' and underlying error 'err'.
+func newOperatorError(op, arg string, err error) error {
+	return &OperatorError{Op: op, Arg: arg, Err: err}
+}
+
+// Error implements the error interface.
+func (e *OperatorError) Error() string {
+	if e.Arg == "" {
+		return fmt.Sprintf("%s: %v", e.Op, e.Err)
+	}
+	return fmt.Sprintf("%s: '%s': %v", e.Op, e.Arg, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *OperatorError) Unwrap() error {
+	return e.Err
+}
+
+// IndexError is returned when an index is out of range.
+// IndexError wraps [ErrIndexOutOfRange].
+type IndexError struct {
+	// Index is the index.
+	Index int
+	// Length is the length of the sequence or -1 if the length was not determined.
+	Length int
+}
+
+// Error implements the error interface.
+func (e *IndexError) Error() string {
+	if e.Length < 0 {
+		return fmt.Sprintf("%v: index %d", ErrIndexOutOfRange, e.Index)
+	}
+	return fmt.Sprintf("%v: index %d, length %d", ErrIndexOutOfRange, e.Index, e.Length)
+}
+
+// Unwrap returns [ErrIndexOutOfRange].
+func (e *IndexError) Unwrap() error {
+	return ErrIndexOutOfRange
+}
+
+// MultipleElementsError is returned when a sequence contains more than one element (or matching element)
+// where at most one is expected. MultipleElementsError wraps [ErrMultipleElements] or [ErrMultipleMatch].
+type MultipleElementsError struct {
+	// Count is the number of elements seen (the sequence is not enumerated further).
+	Count int
+	// Err is [ErrMultipleElements] or [ErrMultipleMatch].
+	Err error
+}
+
+// Error implements the error interface.
+func (e *MultipleElementsError) Error() string {
+	return fmt.Sprintf("%v: at least %d seen", e.Err, e.Count)
+}
+
+// Unwrap returns the underlying error.
+func (e *MultipleElementsError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,125 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestOperatorError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		op, arg string
+		wantIs  error
+		wantMsg string
+	}{
+		{name: "NilSelector",
+			err:     func() error { _, err := ToMapSel(VarAll(1), Identity[int], (func(int) int)(nil)); return err }(),
+			op:      "ToMapSel",
+			arg:     "elementSelector",
+			wantIs:  ErrNilSelector,
+			wantMsg: "ToMapSel: 'elementSelector': nil selector",
+		},
+		{name: "SecondSource",
+			err:     func() error { _, err := Concat(VarAll(1), nil); return err }(),
+			op:      "Concat",
+			arg:     "second",
+			wantIs:  ErrNilSource,
+			wantMsg: "Concat: 'second': nil source",
+		},
+		{name: "DelegatingOperator",
+			err:     errOf(DistinctEq(VarAll(1), nil)),
+			op:      "DistinctEq",
+			arg:     "equal",
+			wantIs:  ErrNilEqual,
+			wantMsg: "DistinctEq: 'equal': nil equal",
+		},
+		{name: "DelegatingHelper",
+			err:     errOf(Strings[int](nil)),
+			op:      "Strings",
+			arg:     "seq",
+			wantIs:  ErrNilSource,
+			wantMsg: "Strings: 'seq': nil source",
+		},
+		{name: "EmptySource",
+			err:     func() error { _, err := First(Empty[int]()); return err }(),
+			op:      "First",
+			wantIs:  ErrEmptySource,
+			wantMsg: "First: empty source",
+		},
+		{name: "IndexOutOfRange",
+			err:     func() error { _, err := ElementAt(VarAll(1, 2, 3), 5); return err }(),
+			op:      "ElementAt",
+			arg:     "index",
+			wantIs:  ErrIndexOutOfRange,
+			wantMsg: "ElementAt: 'index': index out of range: index 5, length 3",
+		},
+		{name: "NegativeIndex",
+			err:     func() error { _, err := ElementAt(VarAll(1, 2, 3), -1); return err }(),
+			op:      "ElementAt",
+			arg:     "index",
+			wantIs:  ErrIndexOutOfRange,
+			wantMsg: "ElementAt: 'index': index out of range: index -1",
+		},
+		{name: "MultipleElements",
+			err:     func() error { _, err := Single(VarAll(1, 2, 3)); return err }(),
+			op:      "Single",
+			wantIs:  ErrMultipleElements,
+			wantMsg: "Single: multiple elements: at least 2 seen",
+		},
+		{name: "MultipleMatch",
+			err: func() error {
+				_, err := SingleOrDefaultPred(VarAll(1, 2, 3), func(i int) bool { return i > 1 })
+				return err
+			}(),
+			op:      "SingleOrDefaultPred",
+			wantIs:  ErrMultipleMatch,
+			wantMsg: "SingleOrDefaultPred: multiple match: at least 2 seen",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var oe *OperatorError
+			if !errors.As(tt.err, &oe) {
+				t.Fatalf("error %v is not OperatorError", tt.err)
+			}
+			if oe.Op != tt.op || oe.Arg != tt.arg {
+				t.Errorf("OperatorError = {%q, %q}, want {%q, %q}", oe.Op, oe.Arg, tt.op, tt.arg)
+			}
+			if !errors.Is(tt.err, tt.wantIs) {
+				t.Errorf("errors.Is(%v, %v) = false", tt.err, tt.wantIs)
+			}
+			if got := tt.err.Error(); got != tt.wantMsg {
+				t.Errorf("Error() = %q, want %q", got, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestIndexError(t *testing.T) {
+	_, err := ElementAt(VarAll("a", "b"), 2)
+	var ie *IndexError
+	if !errors.As(err, &ie) || ie.Index != 2 || ie.Length != 2 {
+		t.Errorf("ElementAt() error = %v, want IndexError{2, 2}", err)
+	}
+	l := NewList(1, 2)
+	err = l.RemoveAt(2)
+	if !errors.As(err, &ie) || ie.Index != 2 || ie.Length != 2 {
+		t.Errorf("RemoveAt() error = %v, want IndexError{2, 2}", err)
+	}
+}
+
+func ExampleOperatorError() {
+	_, err := Where(VarAll(1, 2, 3), nil)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrNilPredicate))
+	var oe *OperatorError
+	if errors.As(err, &oe) {
+		fmt.Println(oe.Op, oe.Arg)
+	}
+	// Output:
+	// Where: 'predicate': nil predicate
+	// true
+	// Where predicate
+}
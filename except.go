@@ -12,8 +12,11 @@ import (
 //
 // [Except]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.except
 func Except[Source any](first, second iter.Seq[Source]) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("Except", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("Except", "second", ErrNilSource)
 	}
 	return ExceptEq(first, second, generichelper.DeepEqual[Source])
 }
@@ -24,11 +27,14 @@ func Except[Source any](first, second iter.Seq[Source]) (iter.Seq[Source], error
 //
 // [ExceptEq]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.except
 func ExceptEq[Source any](first, second iter.Seq[Source], equal func(Source, Source) bool) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("ExceptEq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("ExceptEq", "second", ErrNilSource)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("ExceptEq", "equal", ErrNilEqual)
 	}
 	return ExceptByEq(first, second, Identity[Source], equal)
 }
@@ -39,11 +45,14 @@ func ExceptEq[Source any](first, second iter.Seq[Source], equal func(Source, Sou
 //
 // [ExceptCmp]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.except
 func ExceptCmp[Source any](first, second iter.Seq[Source], compare func(Source, Source) int) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("ExceptCmp", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("ExceptCmp", "second", ErrNilSource)
 	}
 	if compare == nil {
-		return nil, ErrNilCompare
+		return nil, newOperatorError("ExceptCmp", "compare", ErrNilCompare)
 	}
 	return ExceptByCmp(first, second, Identity[Source], compare)
 }
@@ -15,11 +15,14 @@ import (
 //
 // [ExceptBy]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.exceptby
 func ExceptBy[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], keySelector func(Source) Key) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("ExceptBy", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("ExceptBy", "second", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("ExceptBy", "keySelector", ErrNilSelector)
 	}
 	return ExceptByEq(first, second, keySelector, generichelper.DeepEqual[Key])
 }
@@ -32,14 +35,17 @@ func ExceptBy[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], key
 // [ExceptByEq]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.exceptby
 func ExceptByEq[Source, Key any](first iter.Seq[Source], second iter.Seq[Key],
 	keySelector func(Source) Key, equal func(Key, Key) bool) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("ExceptByEq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("ExceptByEq", "second", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("ExceptByEq", "keySelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("ExceptByEq", "equal", ErrNilEqual)
 	}
 	return func(yield func(Source) bool) {
 			distinct1, _ := Distinct(first)
@@ -66,14 +72,17 @@ func ExceptByEq[Source, Key any](first iter.Seq[Source], second iter.Seq[Key],
 // [ExceptByCmp]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.exceptby
 func ExceptByCmp[Source, Key any](first iter.Seq[Source], second iter.Seq[Key],
 	keySelector func(Source) Key, compare func(Key, Key) int) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("ExceptByCmp", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("ExceptByCmp", "second", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("ExceptByCmp", "keySelector", ErrNilSelector)
 	}
 	if compare == nil {
-		return nil, ErrNilCompare
+		return nil, newOperatorError("ExceptByCmp", "compare", ErrNilCompare)
 	}
 	return func(yield func(Source) bool) {
 			distinct1, _ := Distinct(first)
@@ -0,0 +1,248 @@
+package go2linq
+
+import (
+	"cmp"
+	"iter"
+	"reflect"
+	"strings"
+)
+
+// derefType returns the type pointed to by (possibly multiple) pointer type 't'.
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// jsonName returns the field name specified in the field's json tag.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// fieldByName searches struct type 't' for the field with 'name' or json tag name equal to 'name'.
+func fieldByName(t reflect.Type, name string) (reflect.StructField, bool) {
+	if f, ok := t.FieldByName(name); ok {
+		return f, true
+	}
+	for _, f := range reflect.VisibleFields(t) {
+		if jsonName(f) == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// fieldPath resolves dot-separated 'path' against type 't'.
+// fieldPath returns the indexes of the fields along the path and the type of the last field.
+func fieldPath(t reflect.Type, path string) ([][]int, reflect.Type, error) {
+	var idx [][]int
+	ft := t
+	for _, name := range strings.Split(path, ".") {
+		st := derefType(ft)
+		if st.Kind() != reflect.Struct {
+			return nil, nil, &FieldError{Type: t, Path: path, Err: ErrNotStruct}
+		}
+		f, ok := fieldByName(st, name)
+		if !ok {
+			return nil, nil, &FieldError{Type: t, Path: path, Err: ErrUnknownField}
+		}
+		if !f.IsExported() {
+			return nil, nil, &FieldError{Type: t, Path: path, Err: ErrUnexportedField}
+		}
+		idx = append(idx, f.Index)
+		ft = f.Type
+	}
+	return idx, ft, nil
+}
+
+// fieldValue returns the value of the field at 'idx' path in 'v'.
+// If a nil pointer is met along the path, invalid [reflect.Value] is returned.
+func fieldValue(v reflect.Value, idx [][]int) reflect.Value {
+	for _, i := range idx {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		var err error
+		if v, err = v.FieldByIndexErr(i); err != nil {
+			return reflect.Value{}
+		}
+	}
+	return v
+}
+
+// FieldSelector returns a selector that projects a struct (or pointer to struct) into the value of its field.
+// 'path' is a field name or a dot-separated path of nested fields (e.g. "Address.City").
+// Each name in the path matches either the Go field name or the name from the field's json tag.
+// If a nil pointer is met along the path, the selector returns nil.
+func FieldSelector[T any](path string) (func(T) any, error) {
+	sel, err := fieldSelector[T](path)
+	if err != nil {
+		return nil, newOperatorError("FieldSelector", "path", err)
+	}
+	return sel, nil
+}
+
+// fieldSelector is [FieldSelector] that returns bare [*FieldError].
+func fieldSelector[T any](path string) (func(T) any, error) {
+	idx, _, err := fieldPath(reflect.TypeFor[T](), path)
+	if err != nil {
+		return nil, err
+	}
+	return func(t T) any {
+			v := fieldValue(reflect.ValueOf(&t).Elem(), idx)
+			if !v.IsValid() {
+				return nil
+			}
+			return v.Interface()
+		},
+		nil
+}
+
+// isOrderable reports whether values of type 't' may be compared by [compareFieldValues].
+func isOrderable(t reflect.Type) bool {
+	t = derefType(t)
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String, reflect.Bool:
+		return true
+	}
+	return hasCompareMethod(t)
+}
+
+// hasCompareMethod reports whether type 't' has method 'Compare(t) int' (like [time.Time]).
+func hasCompareMethod(t reflect.Type) bool {
+	m, ok := t.MethodByName("Compare")
+	return ok && m.Type.NumIn() == 2 && m.Type.In(1) == t &&
+		m.Type.NumOut() == 1 && m.Type.Out(0).Kind() == reflect.Int
+}
+
+// compareFieldValues compares values of orderable type.
+// Invalid values (from nil pointers) are less than any valid value.
+func compareFieldValues(x, y reflect.Value) int {
+	for x.IsValid() && x.Kind() == reflect.Pointer {
+		x = ptrElem(x)
+	}
+	for y.IsValid() && y.Kind() == reflect.Pointer {
+		y = ptrElem(y)
+	}
+	switch {
+	case !x.IsValid() && !y.IsValid():
+		return 0
+	case !x.IsValid():
+		return -1
+	case !y.IsValid():
+		return +1
+	}
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(x.Int(), y.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(x.Uint(), y.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(x.Float(), y.Float())
+	case reflect.String:
+		return cmp.Compare(x.String(), y.String())
+	case reflect.Bool:
+		switch {
+		case x.Bool() == y.Bool():
+			return 0
+		case x.Bool():
+			return +1
+		}
+		return -1
+	}
+	return int(x.MethodByName("Compare").Call([]reflect.Value{y})[0].Int())
+}
+
+// ptrElem returns the value pointed to by 'v' or invalid value if 'v' is nil.
+func ptrElem(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return reflect.Value{}
+	}
+	return v.Elem()
+}
+
+// OrderByFields sorts the elements of a sequence of structs (or pointers to structs) according to specified fields.
+// Each field is a path as accepted by [FieldSelector], optionally prefixed with '-' for descending
+// (or '+' for ascending) order. Elements equal by the first field are ordered by the next one and so on.
+// The sort is stable. Fields must be of integer, floating-point, string or bool kind
+// or have method 'Compare(T) int' (like [time.Time]), possibly behind pointers (nil pointers sort first).
+func OrderByFields[Source any](source iter.Seq[Source], fields ...string) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("OrderByFields", "source", ErrNilSource)
+	}
+	if len(fields) == 0 {
+		return nil, newOperatorError("OrderByFields", "", ErrNoLesses)
+	}
+	t := reflect.TypeFor[Source]()
+	idxs := make([][][]int, len(fields))
+	descs := make([]bool, len(fields))
+	for i, field := range fields {
+		path := field
+		if rest, ok := strings.CutPrefix(path, "-"); ok {
+			path, descs[i] = rest, true
+		} else {
+			path = strings.TrimPrefix(path, "+")
+		}
+		idx, ft, err := fieldPath(t, path)
+		if err != nil {
+			return nil, newOperatorError("OrderByFields", "fields", err)
+		}
+		if !isOrderable(ft) {
+			return nil, newOperatorError("OrderByFields", "fields", &FieldError{Type: t, Path: path, Err: ErrNotOrderable})
+		}
+		idxs[i] = idx
+	}
+	keySelector := func(s Source) []reflect.Value {
+		v := reflect.ValueOf(&s).Elem()
+		kk := make([]reflect.Value, len(idxs))
+		for i, idx := range idxs {
+			kk[i] = fieldValue(v, idx)
+		}
+		return kk
+	}
+	less := func(x, y []reflect.Value) bool {
+		for i := range x {
+			c := compareFieldValues(x[i], y[i])
+			if descs[i] {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	}
+	return func(yield func(Source) bool) {
+			for s := range orderByKeyLsPrim(source, keySelector, less) {
+				if !yield(s) {
+					return
+				}
+			}
+		},
+		nil
+}
+
+// GroupByField groups the elements of a sequence of structs (or pointers to structs)
+// according to the value of a field specified by 'path' (see [FieldSelector]).
+// The keys are compared using [generichelper.DeepEqual] and kept in hash buckets (see [DeepHash]).
+// 'source' is enumerated immediately.
+func GroupByField[Source any](source iter.Seq[Source], path string) (iter.Seq[Grouping[any, Source]], error) {
+	if source == nil {
+		return nil, newOperatorError("GroupByField", "source", ErrNilSource)
+	}
+	keySelector, err := fieldSelector[Source](path)
+	if err != nil {
+		return nil, newOperatorError("GroupByField", "path", err)
+	}
+	return GroupBy(source, keySelector)
+}
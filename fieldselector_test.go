@@ -0,0 +1,157 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"testing"
+	"time"
+)
+
+type (
+	address struct {
+		City string `json:"city"`
+	}
+	employee struct {
+		Name    string    `json:"name"`
+		Dept    string    `json:"department"`
+		Salary  int       `json:"salary"`
+		Hired   time.Time `json:"hired"`
+		Address *address  `json:"address,omitempty"`
+		Tags    []string
+		secret  string
+	}
+)
+
+var employees = []employee{
+	{Name: "Ann", Dept: "IT", Salary: 300, Address: &address{City: "Oslo"}},
+	{Name: "Bob", Dept: "HR", Salary: 200, Address: &address{City: "Rome"}},
+	{Name: "Cid", Dept: "IT", Salary: 500},
+	{Name: "Dan", Dept: "HR", Salary: 200, Address: &address{City: "Oslo"}},
+	{Name: "Eve", Dept: "IT", Salary: 300, Address: &address{City: "Bern"}},
+}
+
+func employeeNames(ee iter.Seq[employee]) string {
+	s, _ := Select(ee, func(e employee) string { return e.Name })
+	return StringDef(s)
+}
+
+func TestFieldSelector(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		el          any
+		want        any
+		expectedErr error
+	}{
+		{name: "Field", path: "Dept", el: employees[0], want: "IT"},
+		{name: "JSONTag", path: "salary", el: employees[1], want: 200},
+		{name: "Nested", path: "Address.City", el: employees[0], want: "Oslo"},
+		{name: "NestedJSONTag", path: "address.city", el: employees[1], want: "Rome"},
+		{name: "NilPointerInPath", path: "Address.City", el: employees[2], want: nil},
+		{name: "Unknown", path: "Age", expectedErr: ErrUnknownField},
+		{name: "UnknownNested", path: "Address.Zip", expectedErr: ErrUnknownField},
+		{name: "Unexported", path: "secret", expectedErr: ErrUnexportedField},
+		{name: "NotStruct", path: "Name.Length", expectedErr: ErrNotStruct},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := FieldSelector[employee](tt.path)
+			if tt.expectedErr != nil {
+				var fe *FieldError
+				var oe *OperatorError
+				if !errors.Is(err, tt.expectedErr) || !errors.As(err, &fe) || fe.Path != tt.path ||
+					!errors.As(err, &oe) || oe.Op != "FieldSelector" {
+					t.Errorf("FieldSelector() error = %v, expectedErr %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FieldSelector() error = %v", err)
+			}
+			if got := sel(tt.el.(employee)); got != tt.want {
+				t.Errorf("FieldSelector()() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldSelector_pointer(t *testing.T) {
+	sel, _ := FieldSelector[*employee]("Address.City")
+	if got := sel(&employees[4]); got != "Bern" {
+		t.Errorf("FieldSelector()() = %v, want Bern", got)
+	}
+	if got := sel(nil); got != nil {
+		t.Errorf("FieldSelector()(nil) = %v, want nil", got)
+	}
+}
+
+func TestOrderByFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      []string
+		want        string
+		expectedErr error
+	}{
+		{name: "NoFields", expectedErr: ErrNoLesses},
+		{name: "Single", fields: []string{"Name"}, want: "[Ann Bob Cid Dan Eve]"},
+		{name: "Desc", fields: []string{"-Name"}, want: "[Eve Dan Cid Bob Ann]"},
+		{name: "Stable", fields: []string{"Dept"}, want: "[Bob Dan Ann Cid Eve]"},
+		{name: "Multiple", fields: []string{"department", "-Salary", "+Name"}, want: "[Bob Dan Cid Ann Eve]"},
+		{name: "NilPointersFirst", fields: []string{"Address.City", "Name"}, want: "[Cid Eve Ann Dan Bob]"},
+		{name: "CompareMethod", fields: []string{"Hired", "-Name"}, want: "[Eve Dan Cid Bob Ann]"},
+		{name: "NotOrderable", fields: []string{"Name", "Tags"}, expectedErr: ErrNotOrderable},
+		{name: "NotOrderableStruct", fields: []string{"Address"}, expectedErr: ErrNotOrderable},
+		{name: "Unknown", fields: []string{"-Age"}, expectedErr: ErrUnknownField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OrderByFields(SliceAll(employees), tt.fields...)
+			if tt.expectedErr != nil {
+				var oe *OperatorError
+				if !errors.Is(err, tt.expectedErr) || !errors.As(err, &oe) || oe.Op != "OrderByFields" {
+					t.Errorf("OrderByFields() error = %v, expectedErr %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if s := employeeNames(got); s != tt.want {
+				t.Errorf("OrderByFields() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupByField(t *testing.T) {
+	_, err := GroupByField(SliceAll(employees), "Boss")
+	var fe *FieldError
+	var oe *OperatorError
+	if !errors.Is(err, ErrUnknownField) || !errors.As(err, &fe) || fe.Path != "Boss" ||
+		!errors.As(err, &oe) || oe.Op != "GroupByField" {
+		t.Errorf("GroupByField() error = %v, expectedErr %v", err, ErrUnknownField)
+	}
+	gg, _ := GroupByField(SliceAll(employees), "address.city")
+	var got []string
+	for g := range gg {
+		got = append(got, fmt.Sprintf("%v:%s", g.Key(), employeeNames(g.Values())))
+	}
+	want := "[Oslo:[Ann Dan] Rome:[Bob] <nil>:[Cid] Bern:[Eve]]"
+	if s := fmt.Sprint(got); s != want {
+		t.Errorf("GroupByField() = %v, want %v", s, want)
+	}
+}
+
+func ExampleOrderByFields() {
+	sorted, _ := OrderByFields(SliceAll(employees), "Dept", "-Salary")
+	for e := range sorted {
+		fmt.Println(e.Dept, e.Salary, e.Name)
+	}
+	_, err := OrderByFields(SliceAll(employees), "Age")
+	fmt.Println(err)
+	// Output:
+	// HR 200 Bob
+	// HR 200 Dan
+	// IT 500 Cid
+	// IT 300 Ann
+	// IT 300 Eve
+	// OrderByFields: 'fields': unknown field: "Age" in go2linq.employee
+}
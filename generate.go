@@ -0,0 +1,38 @@
+package go2linq
+
+import (
+	"iter"
+)
+
+// Generate generates a sequence by unfolding 'seed':
+// the sequence starts with 'seed' and each next element is obtained by applying 'next' to the previous one.
+// The sequence ends when 'next' returns false.
+func Generate[T any](seed T, next func(T) (T, bool)) (iter.Seq[T], error) {
+	if next == nil {
+		return nil, newOperatorError("Generate", "next", ErrNilNext)
+	}
+	return func(yield func(T) bool) {
+			for v, ok := seed, true; ok; v, ok = next(v) {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+		nil
+}
+
+// Iterate generates an infinite sequence 'seed', next(seed), next(next(seed)), ...
+// Use operators like [Take] or [TakeWhile] to limit the sequence.
+func Iterate[T any](seed T, next func(T) T) (iter.Seq[T], error) {
+	if next == nil {
+		return nil, newOperatorError("Iterate", "next", ErrNilNext)
+	}
+	return func(yield func(T) bool) {
+			for v := seed; ; v = next(v) {
+				if !yield(v) {
+					return
+				}
+			}
+		},
+		nil
+}
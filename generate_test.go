@@ -0,0 +1,63 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	if _, err := Generate(0, nil); !errors.Is(err, ErrNilNext) {
+		t.Errorf("Generate() error = %v, expectedErr %v", err, ErrNilNext)
+	}
+	collatz := func(n int) (int, bool) {
+		if n == 1 {
+			return 0, false
+		}
+		if n%2 == 0 {
+			return n / 2, true
+		}
+		return 3*n + 1, true
+	}
+	got, _ := Generate(6, collatz)
+	if s := StringDef(got); s != "[6 3 10 5 16 8 4 2 1]" {
+		t.Errorf("Generate() = %v", s)
+	}
+	none, _ := Generate(1, func(int) (int, bool) { return 0, false })
+	if s := StringDef(none); s != "[1]" {
+		t.Errorf("Generate() = %v, want [1]", s)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	if _, err := Iterate(0, nil); !errors.Is(err, ErrNilNext) {
+		t.Errorf("Iterate() error = %v, expectedErr %v", err, ErrNilNext)
+	}
+	calls := 0
+	powers, _ := Iterate(1, func(i int) int { calls++; return i * 2 })
+	got, _ := TakeWhile(powers, func(i int) bool { return i < 100 })
+	if s := StringDef(got); s != "[1 2 4 8 16 32 64]" {
+		t.Errorf("Iterate() = %v", s)
+	}
+	if calls != 7 {
+		t.Errorf("Iterate() called next %d times, want 7", calls)
+	}
+}
+
+func ExampleGenerate() {
+	fib, _ := Generate([2]int{0, 1}, func(p [2]int) ([2]int, bool) {
+		return [2]int{p[1], p[0] + p[1]}, p[1] < 50
+	})
+	first, _ := Select(fib, func(p [2]int) int { return p[0] })
+	fmt.Println(StringDef(first))
+	// Output:
+	// [0 1 1 2 3 5 8 13 21 34]
+}
+
+func ExampleIterate() {
+	halves, _ := Iterate(100.0, func(f float64) float64 { return f / 2 })
+	first, _ := Take(halves, 4)
+	fmt.Println(StringDef(first))
+	// Output:
+	// [100 50 25 12.5]
+}
@@ -2,22 +2,21 @@ package go2linq
 
 import (
 	"iter"
-
-	"github.com/solsw/generichelper"
 )
 
 // [GroupBy] groups the elements of a sequence according to a specified key selector function.
-// The keys are compared using [generichelper.DeepEqual]. 'source' is enumerated immediately.
+// The keys are compared using [generichelper.DeepEqual] and kept in hash buckets (see [DeepHash]).
+// 'source' is enumerated immediately.
 //
 // [GroupBy]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.groupby
 func GroupBy[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key) (iter.Seq[Grouping[Key, Source]], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("GroupBy", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("GroupBy", "keySelector", ErrNilSelector)
 	}
-	return GroupBySelEq(source, keySelector, Identity[Source], generichelper.DeepEqual[Key])
+	return GroupBySelComparer(source, keySelector, Identity[Source], DeepComparer[Key]())
 }
 
 // [GroupByEq] groups the elements of a sequence according to a specified key
@@ -27,31 +26,35 @@ func GroupBy[Source, Key any](source iter.Seq[Source], keySelector func(Source)
 func GroupByEq[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key,
 	equal func(Key, Key) bool) (iter.Seq[Grouping[Key, Source]], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("GroupByEq", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("GroupByEq", "keySelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("GroupByEq", "equal", ErrNilEqual)
 	}
 	return GroupBySelEq(source, keySelector, Identity[Source], equal)
 }
 
 // [GroupBySel] groups the elements of a sequence according to a specified key selector function
 // and projects the elements for each group using a specified function.
-// The keys are compared using [generichelper.DeepEqual]. 'source' is enumerated immediately.
+// The keys are compared using [generichelper.DeepEqual] and kept in hash buckets (see [DeepHash]).
+// 'source' is enumerated immediately.
 //
 // [GroupBySel]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.groupby
 func GroupBySel[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key,
 	elementSelector func(Source) Element) (iter.Seq[Grouping[Key, Element]], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("GroupBySel", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("GroupBySel", "keySelector", ErrNilSelector)
 	}
-	if keySelector == nil || elementSelector == nil {
-		return nil, ErrNilSelector
+	if elementSelector == nil {
+		return nil, newOperatorError("GroupBySel", "elementSelector", ErrNilSelector)
 	}
-	return GroupBySelEq(source, keySelector, elementSelector, generichelper.DeepEqual[Key])
+	return GroupBySelComparer(source, keySelector, elementSelector, DeepComparer[Key]())
 }
 
 // [GroupBySelEq] groups the elements of a sequence according to a key selector function.
@@ -62,13 +65,16 @@ func GroupBySel[Source, Key, Element any](source iter.Seq[Source], keySelector f
 func GroupBySelEq[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key,
 	elementSelector func(Source) Element, equal func(Key, Key) bool) (iter.Seq[Grouping[Key, Element]], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("GroupBySelEq", "source", ErrNilSource)
 	}
-	if keySelector == nil || elementSelector == nil {
-		return nil, ErrNilSelector
+	if keySelector == nil {
+		return nil, newOperatorError("GroupBySelEq", "keySelector", ErrNilSelector)
+	}
+	if elementSelector == nil {
+		return nil, newOperatorError("GroupBySelEq", "elementSelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("GroupBySelEq", "equal", ErrNilEqual)
 	}
 	lk, _ := ToLookupSelEq(source, keySelector, elementSelector, equal)
 	return SliceAll(lk.groupings), nil
@@ -76,18 +82,22 @@ func GroupBySelEq[Source, Key, Element any](source iter.Seq[Source], keySelector
 
 // [GroupByRes] groups the elements of a sequence according to a specified key selector function
 // and creates a result value from each group and its key.
-// The keys are compared using [generichelper.DeepEqual]. 'source' is enumerated immediately.
+// The keys are compared using [generichelper.DeepEqual] and kept in hash buckets (see [DeepHash]).
+// 'source' is enumerated immediately.
 //
 // [GroupByRes]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.groupby
 func GroupByRes[Source, Key, Result any](source iter.Seq[Source], keySelector func(Source) Key,
 	resultSelector func(Key, iter.Seq[Source]) Result) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("GroupByRes", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("GroupByRes", "keySelector", ErrNilSelector)
 	}
-	if keySelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if resultSelector == nil {
+		return nil, newOperatorError("GroupByRes", "resultSelector", ErrNilSelector)
 	}
-	return GroupBySelResEq(source, keySelector, Identity[Source], resultSelector, generichelper.DeepEqual[Key])
+	return GroupBySelRes(source, keySelector, Identity[Source], resultSelector)
 }
 
 // [GroupByResEq] groups the elements of a sequence according to a specified key selector function
@@ -98,13 +108,16 @@ func GroupByRes[Source, Key, Result any](source iter.Seq[Source], keySelector fu
 func GroupByResEq[Source, Key, Result any](source iter.Seq[Source], keySelector func(Source) Key,
 	resultSelector func(Key, iter.Seq[Source]) Result, equal func(Key, Key) bool) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("GroupByResEq", "source", ErrNilSource)
 	}
-	if keySelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if keySelector == nil {
+		return nil, newOperatorError("GroupByResEq", "keySelector", ErrNilSelector)
+	}
+	if resultSelector == nil {
+		return nil, newOperatorError("GroupByResEq", "resultSelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("GroupByResEq", "equal", ErrNilEqual)
 	}
 	return GroupBySelResEq(source, keySelector, Identity[Source], resultSelector, equal)
 }
@@ -112,18 +125,28 @@ func GroupByResEq[Source, Key, Result any](source iter.Seq[Source], keySelector
 // [GroupBySelRes] groups the elements of a sequence according to a specified
 // key selector function and creates a result value from each group and its key.
 // The elements of each group are projected using a specified function.
-// Key values are compared using [generichelper.DeepEqual]. 'source' is enumerated immediately.
+// Key values are compared using [generichelper.DeepEqual] and kept in hash buckets (see [DeepHash]).
+// 'source' is enumerated immediately.
 //
 // [GroupBySelRes]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.groupby
 func GroupBySelRes[Source, Key, Element, Result any](source iter.Seq[Source], keySelector func(Source) Key,
 	elementSelector func(Source) Element, resultSelector func(Key, iter.Seq[Element]) Result) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("GroupBySelRes", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("GroupBySelRes", "keySelector", ErrNilSelector)
 	}
-	if keySelector == nil || elementSelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if elementSelector == nil {
+		return nil, newOperatorError("GroupBySelRes", "elementSelector", ErrNilSelector)
 	}
-	return GroupBySelResEq(source, keySelector, elementSelector, resultSelector, generichelper.DeepEqual[Key])
+	if resultSelector == nil {
+		return nil, newOperatorError("GroupBySelRes", "resultSelector", ErrNilSelector)
+	}
+	gg, _ := GroupBySelComparer(source, keySelector, elementSelector, DeepComparer[Key]())
+	return Select(gg, func(g Grouping[Key, Element]) Result {
+		return resultSelector(g.key, g.Values())
+	})
 }
 
 // [GroupBySelResEq] groups the elements of a sequence according to a specified key selector function
@@ -136,16 +159,59 @@ func GroupBySelResEq[Source, Key, Element, Result any](source iter.Seq[Source],
 	elementSelector func(Source) Element, resultSelector func(Key, iter.Seq[Element]) Result,
 	equal func(Key, Key) bool) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("GroupBySelResEq", "source", ErrNilSource)
 	}
-	if keySelector == nil || elementSelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if keySelector == nil {
+		return nil, newOperatorError("GroupBySelResEq", "keySelector", ErrNilSelector)
+	}
+	if elementSelector == nil {
+		return nil, newOperatorError("GroupBySelResEq", "elementSelector", ErrNilSelector)
+	}
+	if resultSelector == nil {
+		return nil, newOperatorError("GroupBySelResEq", "resultSelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("GroupBySelResEq", "equal", ErrNilEqual)
 	}
 	gg, _ := GroupBySelEq(source, keySelector, elementSelector, equal)
 	return Select(gg, func(g Grouping[Key, Element]) Result {
 		return resultSelector(g.key, g.Values())
 	})
 }
+
+// GroupByComparer groups the elements of a sequence according to a specified key selector function.
+// The keys are compared using 'comparer'. 'source' is enumerated immediately.
+func GroupByComparer[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key,
+	comparer EqualityComparer[Key]) (iter.Seq[Grouping[Key, Source]], error) {
+	if source == nil {
+		return nil, newOperatorError("GroupByComparer", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("GroupByComparer", "keySelector", ErrNilSelector)
+	}
+	if comparer == nil {
+		return nil, newOperatorError("GroupByComparer", "comparer", ErrNilComparer)
+	}
+	return GroupBySelComparer(source, keySelector, Identity[Source], comparer)
+}
+
+// GroupBySelComparer groups the elements of a sequence according to a key selector function.
+// The keys are compared using 'comparer' and each group's elements are projected using a specified function.
+// 'source' is enumerated immediately.
+func GroupBySelComparer[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key,
+	elementSelector func(Source) Element, comparer EqualityComparer[Key]) (iter.Seq[Grouping[Key, Element]], error) {
+	if source == nil {
+		return nil, newOperatorError("GroupBySelComparer", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("GroupBySelComparer", "keySelector", ErrNilSelector)
+	}
+	if elementSelector == nil {
+		return nil, newOperatorError("GroupBySelComparer", "elementSelector", ErrNilSelector)
+	}
+	if comparer == nil {
+		return nil, newOperatorError("GroupBySelComparer", "comparer", ErrNilComparer)
+	}
+	lk, _ := ToLookupSelComparer(source, keySelector, elementSelector, comparer)
+	return SliceAll(lk.groupings), nil
+}
@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"math"
@@ -304,3 +305,73 @@ func ExampleGroupBySelRes() {
 	// Minimum age: 1.5
 	// Maximum age: 1.5
 }
+
+func TestGroupByComparer(t *testing.T) {
+	if _, err := GroupByComparer(VarAll("a"), Identity[string], nil); !errors.Is(err, ErrNilComparer) {
+		t.Errorf("GroupByComparer() error = %v, expectedErr %v", err, ErrNilComparer)
+	}
+	groupBy, _ := GroupByComparer(VarAll("abc", "hello", "ABC", "Hello", "xyz"), Identity[string], CaseInsensitiveComparer)
+	var got []string
+	for g := range groupBy {
+		got = append(got, fmt.Sprintf("%s:%s", g.Key(), StringDef(g.Values())))
+	}
+	want := "[abc:[abc ABC] hello:[hello Hello] xyz:[xyz]]"
+	if s := fmt.Sprint(got); s != want {
+		t.Errorf("GroupByComparer() = %v, want %v", s, want)
+	}
+}
+
+func ExampleGroupBySelComparer() {
+	pets := []PetF{
+		{Name: "Barley", Age: 8.3},
+		{Name: "Boots", Age: 4.9},
+		{Name: "Whiskers", Age: 1.5},
+		{Name: "Daisy", Age: 4.3},
+	}
+	// ages differing by no more than a year are considered equal
+	groupBy, _ := GroupBySelComparer(SliceAll(pets),
+		func(pet PetF) float64 { return pet.Age },
+		func(pet PetF) string { return pet.Name },
+		FloatToleranceComparer(1),
+	)
+	for g := range groupBy {
+		fmt.Println(g.Key(), StringDef(g.Values()))
+	}
+	// Output:
+	// 8.3 [Barley]
+	// 4.9 [Boots Daisy]
+	// 1.5 [Whiskers]
+}
+
+func TestGroupByComparer_errorOp(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		wantOp string
+		want   error
+	}{
+		{name: "GroupByComparer source",
+			err:    errOf(GroupByComparer(nil, Identity[string], CaseInsensitiveComparer)),
+			wantOp: "GroupByComparer", want: ErrNilSource},
+		{name: "GroupByComparer keySelector",
+			err:    errOf(GroupByComparer(VarAll("a"), nil, CaseInsensitiveComparer)),
+			wantOp: "GroupByComparer", want: ErrNilSelector},
+		{name: "GroupBySelComparer comparer",
+			err:    errOf(GroupBySelComparer(VarAll("a"), Identity[string], Identity[string], nil)),
+			wantOp: "GroupBySelComparer", want: ErrNilComparer},
+		{name: "ToLookupComparer source",
+			err:    errOf(ToLookupComparer(nil, Identity[string], CaseInsensitiveComparer)),
+			wantOp: "ToLookupComparer", want: ErrNilSource},
+		{name: "ToLookupComparer comparer",
+			err:    errOf(ToLookupComparer(VarAll("a"), Identity[string], nil)),
+			wantOp: "ToLookupComparer", want: ErrNilComparer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var oe *OperatorError
+			if !errors.As(tt.err, &oe) || oe.Op != tt.wantOp || !errors.Is(tt.err, tt.want) {
+				t.Errorf("error = %v, want %v from %s", tt.err, tt.want, tt.wantOp)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package go2linq
 
-import "iter"
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"iter"
+)
 
 // [Grouping] represents a collection of objects that have a common key.
 //
@@ -21,3 +26,53 @@ func (gr *Grouping[Key, Element]) Key() Key {
 func (gr *Grouping[Key, Element]) Values() iter.Seq[Element] {
 	return SliceAll(gr.values)
 }
+
+// groupingData is the serialized representation of [Grouping].
+type groupingData[Key, Element any] struct {
+	Key    Key       `json:"key"`
+	Values []Element `json:"values"`
+}
+
+func (gr Grouping[Key, Element]) data() groupingData[Key, Element] {
+	values := gr.values
+	if values == nil {
+		values = []Element{}
+	}
+	return groupingData[Key, Element]{Key: gr.key, Values: values}
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// Grouping is encoded as JSON object {"key": ..., "values": [...]}.
+func (gr Grouping[Key, Element]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gr.data())
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (gr *Grouping[Key, Element]) UnmarshalJSON(data []byte) error {
+	var gd groupingData[Key, Element]
+	if err := json.Unmarshal(data, &gd); err != nil {
+		return err
+	}
+	gr.key, gr.values = gd.Key, gd.Values
+	return nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface using [gob] encoding.
+// So Grouping may be also encoded with [gob.Encoder].
+func (gr Grouping[Key, Element]) MarshalBinary() ([]byte, error) {
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(gr.data()); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+func (gr *Grouping[Key, Element]) UnmarshalBinary(data []byte) error {
+	var gd groupingData[Key, Element]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&gd); err != nil {
+		return err
+	}
+	gr.key, gr.values = gd.Key, gd.Values
+	return nil
+}
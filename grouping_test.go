@@ -0,0 +1,61 @@
+package go2linq
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGrouping_JSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		grouping Grouping[string, int]
+		want     string
+	}{
+		{name: "1",
+			grouping: Grouping[string, int]{key: "a", values: []int{1, 2, 3}},
+			want:     `{"key":"a","values":[1,2,3]}`,
+		},
+		{name: "NilValues",
+			grouping: Grouping[string, int]{key: "b"},
+			want:     `{"key":"b","values":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.grouping)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", data, tt.want)
+			}
+			var got Grouping[string, int]
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			equal, _ := SequenceEqual(got.Values(), tt.grouping.Values())
+			if got.Key() != tt.grouping.key || !equal {
+				t.Errorf("json.Unmarshal() = %v, want %v", got, tt.grouping)
+			}
+		})
+	}
+}
+
+func TestGrouping_Binary(t *testing.T) {
+	gr := Grouping[int, string]{key: 7, values: []string{"x", "y"}}
+	data, err := gr.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+	var got Grouping[int, string]
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+	equal, _ := SequenceEqual(got.Values(), gr.Values())
+	if got.Key() != 7 || !equal {
+		t.Errorf("UnmarshalBinary() = %v, want %v", got, gr)
+	}
+	if err := got.UnmarshalBinary([]byte("garbage")); err == nil {
+		t.Errorf("UnmarshalBinary() error = nil, want error")
+	}
+}
@@ -14,11 +14,20 @@ import (
 // [GroupJoin]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.groupjoin
 func GroupJoin[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner], outerKeySelector func(Outer) Key,
 	innerKeySelector func(Inner) Key, resultSelector func(Outer, iter.Seq[Inner]) Result) (iter.Seq[Result], error) {
-	if outer == nil || inner == nil {
-		return nil, ErrNilSource
+	if outer == nil {
+		return nil, newOperatorError("GroupJoin", "outer", ErrNilSource)
 	}
-	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if inner == nil {
+		return nil, newOperatorError("GroupJoin", "inner", ErrNilSource)
+	}
+	if outerKeySelector == nil {
+		return nil, newOperatorError("GroupJoin", "outerKeySelector", ErrNilSelector)
+	}
+	if innerKeySelector == nil {
+		return nil, newOperatorError("GroupJoin", "innerKeySelector", ErrNilSelector)
+	}
+	if resultSelector == nil {
+		return nil, newOperatorError("GroupJoin", "resultSelector", ErrNilSelector)
 	}
 	return GroupJoinEq(outer, inner, outerKeySelector, innerKeySelector, resultSelector, generichelper.DeepEqual[Key])
 }
@@ -30,14 +39,23 @@ func GroupJoin[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.
 func GroupJoinEq[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner],
 	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key,
 	resultSelector func(Outer, iter.Seq[Inner]) Result, equal func(Key, Key) bool) (iter.Seq[Result], error) {
-	if outer == nil || inner == nil {
-		return nil, ErrNilSource
+	if outer == nil {
+		return nil, newOperatorError("GroupJoinEq", "outer", ErrNilSource)
+	}
+	if inner == nil {
+		return nil, newOperatorError("GroupJoinEq", "inner", ErrNilSource)
+	}
+	if outerKeySelector == nil {
+		return nil, newOperatorError("GroupJoinEq", "outerKeySelector", ErrNilSelector)
+	}
+	if innerKeySelector == nil {
+		return nil, newOperatorError("GroupJoinEq", "innerKeySelector", ErrNilSelector)
 	}
-	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if resultSelector == nil {
+		return nil, newOperatorError("GroupJoinEq", "resultSelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("GroupJoinEq", "equal", ErrNilEqual)
 	}
 	return func(yield func(Result) bool) {
 			var once sync.Once
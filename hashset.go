@@ -0,0 +1,125 @@
+package go2linq
+
+import (
+	"iter"
+	"slices"
+)
+
+// HashSet represents a set of distinct elements.
+// Elements are distributed into buckets by 'hash' and compared within a bucket by 'equal'.
+// HashSet keeps elements in the order they were added, except that [HashSet.Remove]
+// moves the last element into the place of the removed one.
+// HashSet implements [Counter], [Itemer] and [Slicer].
+type HashSet[T any] struct {
+	items   []T
+	buckets map[uint64][]int
+	equal   func(T, T) bool
+	hash    func(T) uint64
+}
+
+// NewHashSet creates an empty [HashSet] that uses 'equal' and 'hash' to determine equality of elements.
+// 'hash' must return equal values for elements that are equal according to 'equal'.
+func NewHashSet[T any](equal func(T, T) bool, hash func(T) uint64) (*HashSet[T], error) {
+	if equal == nil {
+		return nil, newOperatorError("NewHashSet", "equal", ErrNilEqual)
+	}
+	if hash == nil {
+		return nil, newOperatorError("NewHashSet", "hash", ErrNilHash)
+	}
+	return &HashSet[T]{buckets: make(map[uint64][]int), equal: equal, hash: hash}, nil
+}
+
+// index returns index of the element equal to 't' or -1 if there is no such element.
+func (hs *HashSet[T]) index(t T, h uint64) int {
+	for _, i := range hs.buckets[h] {
+		if hs.equal(t, hs.items[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Add adds element 't' to the HashSet.
+// Add returns false if the HashSet already contains an element equal to 't'.
+func (hs *HashSet[T]) Add(t T) bool {
+	h := hs.hash(t)
+	if hs.index(t, h) >= 0 {
+		return false
+	}
+	hs.buckets[h] = append(hs.buckets[h], len(hs.items))
+	hs.items = append(hs.items, t)
+	return true
+}
+
+// Contains determines whether the HashSet contains an element equal to 't'.
+func (hs *HashSet[T]) Contains(t T) bool {
+	return hs.index(t, hs.hash(t)) >= 0
+}
+
+// Remove removes the element equal to 't' from the HashSet.
+// Remove returns false if there is no such element.
+// To take constant time, Remove moves the last element of the HashSet into the place of the removed one.
+func (hs *HashSet[T]) Remove(t T) bool {
+	h := hs.hash(t)
+	i := hs.index(t, h)
+	if i < 0 {
+		return false
+	}
+	deleteBucketIndex(hs.buckets, h, i)
+	last := len(hs.items) - 1
+	if i != last {
+		hl := hs.hash(hs.items[last])
+		hs.buckets[hl][slices.Index(hs.buckets[hl], last)] = i
+		hs.items[i] = hs.items[last]
+	}
+	var t0 T
+	hs.items[last] = t0
+	hs.items = hs.items[:last]
+	return true
+}
+
+// deleteBucketIndex removes index 'i' from the bucket 'h' of 'buckets'.
+func deleteBucketIndex(buckets map[uint64][]int, h uint64, i int) {
+	if b := slices.DeleteFunc(buckets[h], func(j int) bool { return j == i }); len(b) > 0 {
+		buckets[h] = b
+	} else {
+		delete(buckets, h)
+	}
+}
+
+// removeBucketIndex removes index 'i' from the bucket 'h' of 'buckets'
+// and shifts the greater indices down, so that they stay valid after
+// the element at 'i' is deleted. Elements are not re-hashed.
+func removeBucketIndex(buckets map[uint64][]int, h uint64, i int) {
+	deleteBucketIndex(buckets, h, i)
+	for _, b := range buckets {
+		for k, j := range b {
+			if j > i {
+				b[k] = j - 1
+			}
+		}
+	}
+}
+
+// Count implements the [Counter] interface.
+func (hs *HashSet[T]) Count() int {
+	return len(hs.items)
+}
+
+// Item implements the [Itemer] interface.
+// Item returns the element at the specified index in the order the elements were added.
+// Item panics if 'index' is out of range.
+func (hs *HashSet[T]) Item(index int) T {
+	return hs.items[index]
+}
+
+// Slice implements the [Slicer] interface.
+// Slice returns a copy of the HashSet's elements.
+func (hs *HashSet[T]) Slice() []T {
+	return slices.Clone(hs.items)
+}
+
+// All returns a sequence of the HashSet's elements.
+func (hs *HashSet[T]) All() iter.Seq[T] {
+	return SliceAll(hs.items)
+}
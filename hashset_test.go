@@ -0,0 +1,97 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"testing"
+)
+
+func caseInsensitiveHash(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(strings.ToLower(s)))
+	return h.Sum64()
+}
+
+func TestNewHashSet(t *testing.T) {
+	if _, err := NewHashSet[string](nil, caseInsensitiveHash); !errors.Is(err, ErrNilEqual) {
+		t.Errorf("NewHashSet() error = %v, expectedErr %v", err, ErrNilEqual)
+	}
+	if _, err := NewHashSet[string](caseInsensitiveEqual, nil); !errors.Is(err, ErrNilHash) {
+		t.Errorf("NewHashSet() error = %v, expectedErr %v", err, ErrNilHash)
+	}
+	// constant hash puts all elements into the same bucket
+	hs, _ := NewHashSet(caseInsensitiveEqual, func(string) uint64 { return 0 })
+	for _, s := range []string{"a", "B", "A", "c", "b"} {
+		hs.Add(s)
+	}
+	if got := StringDef(hs.All()); got != "[a B c]" {
+		t.Errorf("All() = %v, want [a B c]", got)
+	}
+	if !hs.Remove("b") || hs.Remove("b") {
+		t.Errorf("Remove() failed")
+	}
+	if hs.Count() != 2 || hs.Item(1) != "c" || !hs.Contains("A") || hs.Contains("B") {
+		t.Errorf("HashSet = %v", hs.Slice())
+	}
+}
+
+func TestToHashSet(t *testing.T) {
+	if _, err := ToHashSet(nil, caseInsensitiveEqual, caseInsensitiveHash); !errors.Is(err, ErrNilSource) {
+		t.Errorf("ToHashSet() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	_, err := ToHashSet(VarAll("a"), caseInsensitiveEqual, nil)
+	if !errors.Is(err, ErrNilHash) {
+		t.Errorf("ToHashSet() error = %v, expectedErr %v", err, ErrNilHash)
+	}
+	var opErr *OperatorError
+	if !errors.As(err, &opErr) || opErr.Op != "ToHashSet" {
+		t.Errorf("ToHashSet() error = %v, want Op ToHashSet", err)
+	}
+	hs, _ := ToHashSet(VarAll("one", "Two", "ONE", "three", "two"), caseInsensitiveEqual, caseInsensitiveHash)
+	if got := StringDef(hs.All()); got != "[one Two three]" {
+		t.Errorf("ToHashSet() = %v, want [one Two three]", got)
+	}
+	if hs.Add("THREE") {
+		t.Errorf("Add(\"THREE\") = true, want false")
+	}
+	hs.Remove("one")
+	for i, want := range []string{"three", "Two"} {
+		if !hs.Contains(strings.ToUpper(want)) || hs.Item(i) != want {
+			t.Errorf("HashSet after Remove() = %v", hs.Slice())
+		}
+	}
+}
+
+func TestHashSet_Remove(t *testing.T) {
+	// hash by remainder puts elements into several buckets
+	hs, _ := NewHashSet(func(x, y int) bool { return x == y }, func(x int) uint64 { return uint64(x % 3) })
+	for i := range 10 {
+		hs.Add(i)
+	}
+	for _, r := range []int{4, 0, 9, 5} {
+		if !hs.Remove(r) {
+			t.Fatalf("Remove(%d) = false, want true", r)
+		}
+	}
+	// each removed element is replaced by the last one
+	if got := StringDef(hs.All()); got != "[8 1 2 3 7 6]" {
+		t.Errorf("All() after Remove() = %v, want [8 1 2 3 7 6]", got)
+	}
+	for i := range 10 {
+		if want := i != 0 && i != 4 && i != 5 && i != 9; hs.Contains(i) != want {
+			t.Errorf("Contains(%d) = %v, want %v", i, !want, want)
+		}
+	}
+	if hs.Add(3) || !hs.Add(4) || hs.Item(6) != 4 {
+		t.Errorf("Add() after Remove() failed: %v", hs.Slice())
+	}
+}
+
+func ExampleToHashSet() {
+	hs, _ := ToHashSet(VarAll("apple", "Banana", "APPLE", "banana", "cherry"), caseInsensitiveEqual, caseInsensitiveHash)
+	fmt.Println(hs.Count(), StringDef(hs.All()))
+	// Output:
+	// 3 [apple Banana cherry]
+}
@@ -125,3 +125,8 @@ func Sec2_int_string(n int) iter.Seq2[int, string] {
 		}
 	}
 }
+
+// errOf returns the error result of a call returning a value and an error.
+func errOf[T any](_ T, err error) error {
+	return err
+}
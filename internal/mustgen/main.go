@@ -0,0 +1,491 @@
+// Command mustgen generates Must counterparts of the go2linq operators.
+//
+// For every exported function of the package in the current directory whose last result is error,
+// mustgen emits a function with "Must" suffix that returns the remaining results and panics in case of error.
+// mustgen also emits a test verifying that each generated function panics with the error
+// returned by the original function.
+//
+// mustgen is intended to be run by "go generate".
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	output     = flag.String("output", "must.go", "output file name")
+	testOutput = flag.String("test", "must_test.go", "output test file name")
+)
+
+const header = "// Code generated by mustgen. DO NOT EDIT.\n\n"
+
+// operator is an exported function whose last result is error.
+type operator struct {
+	decl    *ast.FuncDecl
+	imports map[string]string // package name -> import path of the declaring file
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mustgen: ")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	pkgName, ops, err := parseOperators(fset, ".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(fset, pkgName, ops)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+	testSrc, err := generateTest(fset, pkgName, ops)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*testOutput, testSrc, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseOperators parses non-test Go files in 'dir' (except the generated ones)
+// and returns the package name and the operators sorted by name.
+func parseOperators(fset *token.FileSet, dir string) (string, []operator, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+	var pkgName string
+	var ops []operator
+	declared := make(map[string]bool)
+	for _, name := range names {
+		base := filepath.Base(name)
+		if strings.HasSuffix(base, "_test.go") || base == *output {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+		pkgName = f.Name.Name
+		imports := make(map[string]string)
+		for _, is := range f.Imports {
+			path, _ := strconv.Unquote(is.Path.Value)
+			if is.Name != nil {
+				imports[is.Name.Name] = path
+			} else {
+				imports[importName(path)] = path
+			}
+		}
+		for _, d := range f.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil {
+				continue
+			}
+			declared[fd.Name.Name] = true
+			if fd.Name.IsExported() && returnsError(fd.Type) {
+				ops = append(ops, operator{decl: fd, imports: imports})
+			}
+		}
+	}
+	if pkgName == "" {
+		return "", nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	for _, op := range ops {
+		if name := op.decl.Name.Name + "Must"; declared[name] {
+			return "", nil, fmt.Errorf("%s is already declared", name)
+		}
+	}
+	slices.SortFunc(ops, func(a, b operator) int { return strings.Compare(a.decl.Name.Name, b.decl.Name.Name) })
+	return pkgName, ops, nil
+}
+
+// importName returns the default name of the package imported by 'path'
+// ("rand" for "math/rand/v2").
+func importName(path string) string {
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = elems[len(elems)-2]
+	}
+	return name
+}
+
+// returnsError reports whether the last result of 'ft' is error.
+func returnsError(ft *ast.FuncType) bool {
+	if ft.Results == nil || len(ft.Results.List) == 0 {
+		return false
+	}
+	id, ok := ft.Results.List[len(ft.Results.List)-1].Type.(*ast.Ident)
+	return ok && id.Name == "error"
+}
+
+// param is a function parameter.
+type param struct {
+	name     string
+	typ      ast.Expr
+	variadic bool
+}
+
+// params returns the parameters of 'ft' assigning names to the unnamed ones.
+func params(ft *ast.FuncType) []param {
+	var pp []param
+	for _, f := range ft.Params.List {
+		typ, variadic := f.Type, false
+		if e, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = e.Elt, true
+		}
+		if len(f.Names) == 0 {
+			pp = append(pp, param{name: fmt.Sprintf("p%d", len(pp)), typ: typ, variadic: variadic})
+			continue
+		}
+		for _, n := range f.Names {
+			name := n.Name
+			if name == "_" {
+				name = fmt.Sprintf("p%d", len(pp))
+			}
+			pp = append(pp, param{name: name, typ: typ, variadic: variadic})
+		}
+	}
+	return pp
+}
+
+// results returns the types of the results of 'ft' except the trailing error.
+func results(ft *ast.FuncType) []ast.Expr {
+	var rr []ast.Expr
+	for _, f := range ft.Results.List[:len(ft.Results.List)-1] {
+		for range max(len(f.Names), 1) {
+			rr = append(rr, f.Type)
+		}
+	}
+	return rr
+}
+
+// typeParams returns the type parameters' names of 'ft'.
+func typeParams(ft *ast.FuncType) []string {
+	var tt []string
+	if ft.TypeParams != nil {
+		for _, f := range ft.TypeParams.List {
+			for _, n := range f.Names {
+				tt = append(tt, n.Name)
+			}
+		}
+	}
+	return tt
+}
+
+// usedImports adds to 'used' the import paths of the packages referenced in 'node'.
+func usedImports(node ast.Node, imports map[string]string, used map[string]bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if se, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := se.X.(*ast.Ident); ok {
+				if path, ok := imports[id.Name]; ok {
+					used[path] = true
+				}
+			}
+			return false
+		}
+		return true
+	})
+}
+
+func writeImports(b *bytes.Buffer, used map[string]bool) {
+	paths := make([]string, 0, len(used))
+	for p := range used {
+		paths = append(paths, p)
+	}
+	// standard library packages go first
+	thirdParty := func(p string) bool { return strings.Contains(strings.Split(p, "/")[0], ".") }
+	slices.SortFunc(paths, func(a, b string) int {
+		if ta, tb := thirdParty(a), thirdParty(b); ta != tb {
+			if ta {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(a, b)
+	})
+	b.WriteString("import (\n")
+	for i, p := range paths {
+		if i > 0 && thirdParty(p) && !thirdParty(paths[i-1]) {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "\t%q\n", p)
+	}
+	b.WriteString(")\n\n")
+}
+
+func nodeString(fset *token.FileSet, node any) string {
+	var b bytes.Buffer
+	if err := format.Node(&b, fset, node); err != nil {
+		panic(err)
+	}
+	return b.String()
+}
+
+// callExpr returns the call of the original operator with parameters' names as arguments.
+func callExpr(fd *ast.FuncDecl, typeArgs []string, args []string) string {
+	call := fd.Name.Name
+	if len(typeArgs) > 0 {
+		call += "[" + strings.Join(typeArgs, ", ") + "]"
+	}
+	return call + "(" + strings.Join(args, ", ") + ")"
+}
+
+func generate(fset *token.FileSet, pkgName string, ops []operator) ([]byte, error) {
+	used := make(map[string]bool)
+	var body bytes.Buffer
+	for _, op := range ops {
+		fd := op.decl
+		usedImports(fd.Type, op.imports, used)
+		var args []string
+		for _, p := range params(fd.Type) {
+			if p.variadic {
+				args = append(args, p.name+"...")
+			} else {
+				args = append(args, p.name)
+			}
+		}
+		var pdecls []string
+		for _, p := range params(fd.Type) {
+			t := nodeString(fset, p.typ)
+			if p.variadic {
+				t = "..." + t
+			}
+			pdecls = append(pdecls, p.name+" "+t)
+		}
+		var tparams string
+		if fd.Type.TypeParams != nil {
+			var tt []string
+			for _, f := range fd.Type.TypeParams.List {
+				var names []string
+				for _, n := range f.Names {
+					names = append(names, n.Name)
+				}
+				tt = append(tt, strings.Join(names, ", ")+" "+nodeString(fset, f.Type))
+			}
+			tparams = "[" + strings.Join(tt, ", ") + "]"
+		}
+		rr := results(fd.Type)
+		var rtypes, rnames []string
+		for i, r := range rr {
+			rtypes = append(rtypes, nodeString(fset, r))
+			rnames = append(rnames, fmt.Sprintf("r%d", i))
+		}
+		name := fd.Name.Name
+		fmt.Fprintf(&body, "// %sMust is like [%s] but panics in case of error.\n", name, name)
+		fmt.Fprintf(&body, "func %sMust%s(%s)", name, tparams, strings.Join(pdecls, ", "))
+		switch len(rtypes) {
+		case 0:
+		case 1:
+			fmt.Fprintf(&body, " %s", rtypes[0])
+		default:
+			fmt.Fprintf(&body, " (%s)", strings.Join(rtypes, ", "))
+		}
+		body.WriteString(" {\n")
+		call := callExpr(fd, typeParams(fd.Type), args)
+		if len(rnames) == 0 {
+			fmt.Fprintf(&body, "if err := %s; err != nil {\npanic(err)\n}\n}\n\n", call)
+			continue
+		}
+		fmt.Fprintf(&body, "%s, err := %s\nif err != nil {\npanic(err)\n}\nreturn %s\n}\n\n",
+			strings.Join(rnames, ", "), call, strings.Join(rnames, ", "))
+	}
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkgName)
+	writeImports(&b, used)
+	b.Write(body.Bytes())
+	return format.Source(b.Bytes())
+}
+
+// substitute returns a copy of type expression 'e' with type parameters replaced according to 'subst'.
+func substitute(fset *token.FileSet, e ast.Expr, subst map[string]ast.Expr) ast.Expr {
+	c, err := parser.ParseExpr(nodeString(fset, e))
+	if err != nil {
+		panic(err)
+	}
+	var replace func(ast.Expr) ast.Expr
+	replace = func(x ast.Expr) ast.Expr {
+		if id, ok := x.(*ast.Ident); ok {
+			if s, ok := subst[id.Name]; ok {
+				return s
+			}
+		}
+		return x
+	}
+	ast.Inspect(c, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			return false
+		case *ast.IndexExpr:
+			n.X, n.Index = replace(n.X), replace(n.Index)
+		case *ast.IndexListExpr:
+			for i := range n.Indices {
+				n.Indices[i] = replace(n.Indices[i])
+			}
+		case *ast.ArrayType:
+			n.Elt = replace(n.Elt)
+		case *ast.MapType:
+			n.Key, n.Value = replace(n.Key), replace(n.Value)
+		case *ast.ChanType:
+			n.Value = replace(n.Value)
+		case *ast.Ellipsis:
+			n.Elt = replace(n.Elt)
+		case *ast.StarExpr:
+			n.X = replace(n.X)
+		case *ast.Field:
+			n.Type = replace(n.Type)
+		}
+		return true
+	})
+	return replace(c)
+}
+
+// typeArgs returns type arguments the operator is instantiated with in the test:
+// the core type of a constraint like ~[]E or int otherwise.
+func typeArgs(fset *token.FileSet, ft *ast.FuncType) ([]ast.Expr, map[string]ast.Expr) {
+	subst := make(map[string]ast.Expr)
+	var args []ast.Expr
+	if ft.TypeParams == nil {
+		return nil, subst
+	}
+	for _, f := range ft.TypeParams.List {
+		for _, n := range f.Names {
+			subst[n.Name] = ast.NewIdent("int")
+		}
+	}
+	for _, f := range ft.TypeParams.List {
+		for _, n := range f.Names {
+			if u, ok := f.Type.(*ast.UnaryExpr); ok && u.Op == token.TILDE {
+				subst[n.Name] = substitute(fset, u.X, subst)
+			}
+			args = append(args, subst[n.Name])
+		}
+	}
+	return args, subst
+}
+
+// testArg returns the argument of type 't' the operator is called with in the test:
+// -1 for signed numbers (to make operators like [go2linq.Range] fail) and the zero value otherwise.
+func testArg(fset *token.FileSet, t ast.Expr) string {
+	switch t := t.(type) {
+	case *ast.FuncType, *ast.ChanType, *ast.MapType, *ast.StarExpr, *ast.ArrayType, *ast.InterfaceType:
+		return "nil"
+	case *ast.Ident:
+		switch t.Name {
+		case "bool":
+			return "false"
+		case "string":
+			return `""`
+		case "error", "any":
+			return "nil"
+		case "int", "int8", "int16", "int32", "int64", "float32", "float64", "rune":
+			return "-1"
+		case "uint", "uint8", "uint16", "uint32", "uint64", "uintptr", "byte":
+			return "0"
+		}
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		var x ast.Expr
+		if ie, ok := t.(*ast.IndexExpr); ok {
+			x = ie.X
+		} else {
+			x = t.(*ast.IndexListExpr).X
+		}
+		if se, ok := x.(*ast.SelectorExpr); ok {
+			if id, ok := se.X.(*ast.Ident); ok && id.Name == "iter" {
+				return "nil"
+			}
+		}
+	}
+	return "*new(" + nodeString(fset, t) + ")"
+}
+
+func generateTest(fset *token.FileSet, pkgName string, ops []operator) ([]byte, error) {
+	used := map[string]bool{"reflect": true, "testing": true}
+	var body bytes.Buffer
+	body.WriteString(`// TestMust verifies that each Must function called with nil, zero or negative arguments
+// panics with the error returned by the corresponding function and does not panic otherwise.
+func TestMust(t *testing.T) {
+	tests := []struct {
+		name string
+		call func() error
+		must func()
+	}{
+`)
+	for _, op := range ops {
+		fd := op.decl
+		targs, subst := typeArgs(fset, fd.Type)
+		var targNames []string
+		for _, ta := range targs {
+			usedImports(ta, op.imports, used)
+			targNames = append(targNames, nodeString(fset, ta))
+		}
+		var args []string
+		for _, p := range params(fd.Type) {
+			if p.variadic {
+				continue
+			}
+			typ := substitute(fset, p.typ, subst)
+			z := testArg(fset, typ)
+			if strings.HasPrefix(z, "*new(") {
+				usedImports(typ, op.imports, used)
+			}
+			args = append(args, z)
+		}
+		blanks := strings.Repeat("_, ", len(results(fd.Type)))
+		name := fd.Name.Name
+		origCall := callExpr(fd, targNames, args)
+		fmt.Fprintf(&body, "{name: %q,\n", name)
+		if blanks == "" {
+			fmt.Fprintf(&body, "call: func() error { return %s },\n", origCall)
+			fmt.Fprintf(&body, "must: func() { %sMust%s },\n},\n", name, strings.TrimPrefix(origCall, name))
+			continue
+		}
+		fmt.Fprintf(&body, "call: func() error { %serr := %s; return err },\n", blanks, origCall)
+		fmt.Fprintf(&body, "must: func() { %s= %sMust%s },\n},\n",
+			strings.TrimSuffix(blanks, ", ")+" ", name, strings.TrimPrefix(origCall, name))
+	}
+	body.WriteString(`	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.call()
+			defer func() {
+				got := recover()
+				if want == nil {
+					if got != nil {
+						t.Errorf("%sMust() panicked with %v", tt.name, got)
+					}
+					return
+				}
+				if err, ok := got.(error); !ok || !reflect.DeepEqual(err, want) {
+					t.Errorf("%sMust() panicked with %v, want %v", tt.name, got, want)
+				}
+			}()
+			tt.must()
+		})
+	}
+}
+`)
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkgName)
+	writeImports(&b, used)
+	b.Write(body.Bytes())
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGeneratedUpToDate verifies that the generated files match the current operators.
+func TestGeneratedUpToDate(t *testing.T) {
+	const dir = "../.."
+	fset := token.NewFileSet()
+	pkgName, ops, err := parseOperators(fset, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := generate(fset, pkgName, ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSrc, err := generateTest(fset, pkgName, ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string][]byte{*output: src, *testOutput: testSrc} {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run 'go generate'", name)
+		}
+	}
+}
+
+func TestImportName(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "iter", want: "iter"},
+		{path: "golang.org/x/exp/constraints", want: "constraints"},
+		{path: "math/rand/v2", want: "rand"},
+		{path: "github.com/solsw/go2linq/v4", want: "go2linq"},
+		{path: "example.com/v", want: "v"},
+		{path: "example.com/vx", want: "vx"},
+	}
+	for _, tt := range tests {
+		if got := importName(tt.path); got != tt.want {
+			t.Errorf("importName(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
@@ -12,8 +12,11 @@ import (
 //
 // [Intersect]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.intersect
 func Intersect[Source any](first, second iter.Seq[Source]) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("Intersect", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("Intersect", "second", ErrNilSource)
 	}
 	return IntersectEq(first, second, generichelper.DeepEqual[Source])
 }
@@ -24,11 +27,14 @@ func Intersect[Source any](first, second iter.Seq[Source]) (iter.Seq[Source], er
 //
 // [IntersectEq]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.intersect
 func IntersectEq[Source any](first, second iter.Seq[Source], equal func(Source, Source) bool) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("IntersectEq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("IntersectEq", "second", ErrNilSource)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("IntersectEq", "equal", ErrNilEqual)
 	}
 	return seqIntersectByEq(first, second, Identity[Source], equal, equal, nil),
 		nil
@@ -40,11 +46,14 @@ func IntersectEq[Source any](first, second iter.Seq[Source], equal func(Source,
 //
 // [IntersectCmp]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.intersect
 func IntersectCmp[Source any](first, second iter.Seq[Source], compare func(Source, Source) int) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("IntersectCmp", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("IntersectCmp", "second", ErrNilSource)
 	}
 	if compare == nil {
-		return nil, ErrNilCompare
+		return nil, newOperatorError("IntersectCmp", "compare", ErrNilCompare)
 	}
 	return seqIntersectByEq(first, second, Identity[Source],
 			func(a, b Source) bool { return compare(a, b) == 0 }, nil, compare),
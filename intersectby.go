@@ -15,8 +15,11 @@ import (
 //
 // [IntersectBy]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.intersectby
 func IntersectBy[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], keySelector func(Source) Key) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("IntersectBy", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("IntersectBy", "second", ErrNilSource)
 	}
 	return IntersectByEq(first, second, keySelector, generichelper.DeepEqual[Key])
 }
@@ -65,14 +68,17 @@ func seqIntersectByEq[Source, Key any](first iter.Seq[Source], second iter.Seq[K
 // [IntersectByEq]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.intersectby
 func IntersectByEq[Source, Key any](first iter.Seq[Source], second iter.Seq[Key],
 	keySelector func(Source) Key, equal func(Key, Key) bool) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("IntersectByEq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("IntersectByEq", "second", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("IntersectByEq", "keySelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("IntersectByEq", "equal", ErrNilEqual)
 	}
 	return seqIntersectByEq(first, second, keySelector, generichelper.DeepEqual[Source], equal, nil),
 		nil
@@ -86,14 +92,17 @@ func IntersectByEq[Source, Key any](first iter.Seq[Source], second iter.Seq[Key]
 // [IntersectByCmp]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.intersectby
 func IntersectByCmp[Source, Key any](first iter.Seq[Source], second iter.Seq[Key],
 	keySelector func(Source) Key, compare func(Key, Key) int) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("IntersectByCmp", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("IntersectByCmp", "second", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("IntersectByCmp", "keySelector", ErrNilSelector)
 	}
 	if compare == nil {
-		return nil, ErrNilCompare
+		return nil, newOperatorError("IntersectByCmp", "compare", ErrNilCompare)
 	}
 	return seqIntersectByEq(first, second, keySelector, generichelper.DeepEqual[Source], nil, compare),
 		nil
@@ -3,25 +3,32 @@ package go2linq
 import (
 	"iter"
 	"sync"
-
-	"github.com/solsw/generichelper"
 )
 
 // [Join] correlates the elements of two sequences based on matching keys.
-// [generichelper.DeepEqual] is used to compare keys.
+// [generichelper.DeepEqual] is used to compare keys, which are kept in hash buckets (see [DeepHash]).
 // 'inner' is enumerated on the first iteration over the result.
 //
 // [Join]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.join
 func Join[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner],
 	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key,
 	resultSelector func(Outer, Inner) Result) (iter.Seq[Result], error) {
-	if outer == nil || inner == nil {
-		return nil, ErrNilSource
+	if outer == nil {
+		return nil, newOperatorError("Join", "outer", ErrNilSource)
+	}
+	if inner == nil {
+		return nil, newOperatorError("Join", "inner", ErrNilSource)
+	}
+	if outerKeySelector == nil {
+		return nil, newOperatorError("Join", "outerKeySelector", ErrNilSelector)
+	}
+	if innerKeySelector == nil {
+		return nil, newOperatorError("Join", "innerKeySelector", ErrNilSelector)
 	}
-	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if resultSelector == nil {
+		return nil, newOperatorError("Join", "resultSelector", ErrNilSelector)
 	}
-	return JoinEq(outer, inner, outerKeySelector, innerKeySelector, resultSelector, generichelper.DeepEqual[Key])
+	return JoinComparer(outer, inner, outerKeySelector, innerKeySelector, resultSelector, DeepComparer[Key]())
 }
 
 // [JoinEq] correlates the elements of two sequences based on matching keys.
@@ -35,14 +42,23 @@ func Join[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[I
 func JoinEq[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner],
 	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key,
 	resultSelector func(Outer, Inner) Result, equal func(Key, Key) bool) (iter.Seq[Result], error) {
-	if outer == nil || inner == nil {
-		return nil, ErrNilSource
+	if outer == nil {
+		return nil, newOperatorError("JoinEq", "outer", ErrNilSource)
+	}
+	if inner == nil {
+		return nil, newOperatorError("JoinEq", "inner", ErrNilSource)
+	}
+	if outerKeySelector == nil {
+		return nil, newOperatorError("JoinEq", "outerKeySelector", ErrNilSelector)
+	}
+	if innerKeySelector == nil {
+		return nil, newOperatorError("JoinEq", "innerKeySelector", ErrNilSelector)
 	}
-	if outerKeySelector == nil || innerKeySelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if resultSelector == nil {
+		return nil, newOperatorError("JoinEq", "resultSelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("JoinEq", "equal", ErrNilEqual)
 	}
 	return func(yield func(Result) bool) {
 			var once sync.Once
@@ -62,3 +78,42 @@ func JoinEq[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq
 		},
 		nil
 }
+
+// JoinComparer correlates the elements of two sequences based on matching keys.
+// 'comparer' is used to compare keys.
+// 'inner' is enumerated on the first iteration over the result.
+func JoinComparer[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner],
+	outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key,
+	resultSelector func(Outer, Inner) Result, comparer EqualityComparer[Key]) (iter.Seq[Result], error) {
+	if outer == nil {
+		return nil, newOperatorError("JoinComparer", "outer", ErrNilSource)
+	}
+	if inner == nil {
+		return nil, newOperatorError("JoinComparer", "inner", ErrNilSource)
+	}
+	if outerKeySelector == nil {
+		return nil, newOperatorError("JoinComparer", "outerKeySelector", ErrNilSelector)
+	}
+	if innerKeySelector == nil {
+		return nil, newOperatorError("JoinComparer", "innerKeySelector", ErrNilSelector)
+	}
+	if resultSelector == nil {
+		return nil, newOperatorError("JoinComparer", "resultSelector", ErrNilSelector)
+	}
+	if comparer == nil {
+		return nil, newOperatorError("JoinComparer", "comparer", ErrNilComparer)
+	}
+	return func(yield func(Result) bool) {
+			var once sync.Once
+			var ilk *Lookup[Key, Inner]
+			for o := range outer {
+				once.Do(func() { ilk, _ = ToLookupComparer(inner, innerKeySelector, comparer) })
+				for _, i := range ilk.itemSlice(outerKeySelector(o)) {
+					if !yield(resultSelector(o, i)) {
+						return
+					}
+				}
+			}
+		},
+		nil
+}
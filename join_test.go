@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"strings"
@@ -193,3 +194,23 @@ func ExampleJoin_ex2() {
 	// Kiwi - Fruit
 	// Carrot - Vegetable
 }
+
+func TestJoinComparer(t *testing.T) {
+	if _, err := JoinComparer(VarAll("a"), VarAll("b"), Identity[string], Identity[string],
+		func(oel, iel string) string { return oel + iel }, nil); !errors.Is(err, ErrNilComparer) {
+		t.Errorf("JoinComparer() error = %v, expectedErr %v", err, ErrNilComparer)
+	}
+	got, _ := JoinComparer(
+		VarAll("ABCxxx", "abcyyy", "defzzz", "ghizzz"),
+		VarAll("000abc", "111gHi", "222333", "444ABC"),
+		func(oel string) string { return oel[:3] },
+		func(iel string) string { return iel[3:] },
+		func(oel, iel string) string { return oel + ":" + iel },
+		CaseInsensitiveComparer,
+	)
+	want := VarAll("ABCxxx:000abc", "ABCxxx:444ABC", "abcyyy:000abc", "abcyyy:444ABC", "ghizzz:111gHi")
+	equal, _ := SequenceEqual(got, want)
+	if !equal {
+		t.Errorf("JoinComparer = %v, want %v", StringDef(got), StringDef(want))
+	}
+}
@@ -0,0 +1,68 @@
+package go2linq
+
+import (
+	"iter"
+	"slices"
+)
+
+// List represents a list of elements that can be accessed by index.
+// List implements [Counter], [Itemer] and [Slicer].
+type List[T any] struct {
+	items []T
+}
+
+// NewList creates a [List] containing specified elements.
+func NewList[T any](items ...T) *List[T] {
+	return &List[T]{items: slices.Clone(items)}
+}
+
+// Add adds elements to the end of the List.
+func (l *List[T]) Add(items ...T) {
+	l.items = append(l.items, items...)
+}
+
+// Insert inserts element 't' into the List at the specified index.
+func (l *List[T]) Insert(index int, t T) error {
+	if index < 0 || index > len(l.items) {
+		return newOperatorError("List.Insert", "index", &IndexError{Index: index, Length: len(l.items)})
+	}
+	l.items = slices.Insert(l.items, index, t)
+	return nil
+}
+
+// RemoveAt removes the element at the specified index of the List.
+func (l *List[T]) RemoveAt(index int) error {
+	if index < 0 || index >= len(l.items) {
+		return newOperatorError("List.RemoveAt", "index", &IndexError{Index: index, Length: len(l.items)})
+	}
+	l.items = slices.Delete(l.items, index, index+1)
+	return nil
+}
+
+// Clear removes all elements from the List.
+func (l *List[T]) Clear() {
+	clear(l.items)
+	l.items = l.items[:0]
+}
+
+// Count implements the [Counter] interface.
+func (l *List[T]) Count() int {
+	return len(l.items)
+}
+
+// Item implements the [Itemer] interface.
+// Item panics if 'index' is out of range.
+func (l *List[T]) Item(index int) T {
+	return l.items[index]
+}
+
+// Slice implements the [Slicer] interface.
+// Slice returns a copy of the List's elements.
+func (l *List[T]) Slice() []T {
+	return slices.Clone(l.items)
+}
+
+// All returns a sequence of the List's elements.
+func (l *List[T]) All() iter.Seq[T] {
+	return SliceAll(l.items)
+}
@@ -0,0 +1,73 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+var (
+	_ Counter     = (*List[int])(nil)
+	_ Itemer[int] = (*List[int])(nil)
+	_ Slicer[int] = (*List[int])(nil)
+	_ Counter     = (*HashSet[int])(nil)
+	_ Itemer[int] = (*HashSet[int])(nil)
+	_ Slicer[int] = (*HashSet[int])(nil)
+	_ Counter     = (*SortedSet[int])(nil)
+	_ Itemer[int] = (*SortedSet[int])(nil)
+	_ Slicer[int] = (*SortedSet[int])(nil)
+	_ Counter     = (*Dictionary[int, int])(nil)
+)
+
+func TestList(t *testing.T) {
+	l := NewList(1, 2, 3)
+	l.Add(4, 5)
+	if err := l.Insert(0, 0); err != nil {
+		t.Errorf("Insert() error = %v", err)
+	}
+	if err := l.RemoveAt(3); err != nil {
+		t.Errorf("RemoveAt() error = %v", err)
+	}
+	if err := l.Insert(7, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Insert() error = %v, expectedErr %v", err, ErrIndexOutOfRange)
+	}
+	if err := l.RemoveAt(-1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("RemoveAt() error = %v, expectedErr %v", err, ErrIndexOutOfRange)
+	}
+	if got := StringDef(l.All()); got != "[0 1 2 4 5]" {
+		t.Errorf("All() = %v, want [0 1 2 4 5]", got)
+	}
+	if l.Count() != 5 || l.Item(3) != 4 {
+		t.Errorf("Count() = %v, Item(3) = %v", l.Count(), l.Item(3))
+	}
+	s := l.Slice()
+	s[0] = 100
+	if l.Item(0) != 0 {
+		t.Errorf("Slice() is not a copy")
+	}
+	l.Clear()
+	if l.Count() != 0 {
+		t.Errorf("Clear() failed")
+	}
+}
+
+func TestToList(t *testing.T) {
+	if _, err := ToList[int](nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("ToList() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	rng, _ := Range(1, 10)
+	where, _ := Where(rng, func(i int) bool { return i%3 == 0 })
+	got, _ := ToList(where)
+	if equal, _ := SequenceEqual(got.All(), VarAll(3, 6, 9)); !equal {
+		t.Errorf("ToList() = %v, want [3 6 9]", StringDef(got.All()))
+	}
+}
+
+func ExampleToList() {
+	l, _ := ToList(VarAll("one", "two", "three"))
+	_ = l.Insert(1, "one and a half")
+	lengths, _ := Select(l.All(), func(s string) int { return len(s) })
+	fmt.Println(l.Count(), StringDef(lengths))
+	// Output:
+	// 4 [3 14 3 5]
+}
@@ -1,6 +1,9 @@
 package go2linq
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"iter"
 	"reflect"
 	"slices"
@@ -11,13 +14,71 @@ import (
 // [Lookup]: https://learn.microsoft.com/dotnet/api/system.linq.Lookup-2
 type Lookup[Key, Element any] struct {
 	groupings []Grouping[Key, Element]
-	// KeyEqual is an equaler for groupings' keys.
+	// KeyEqual (if not nil) is an equaler for groupings' keys that overrides the Lookup's comparer.
+	// Lookups created with an [EqualityComparer] (e.g. by [ToLookup] or [ToLookupSelComparer])
+	// have nil KeyEqual and find keys by the comparer's hash.
+	// If KeyEqual is set, keys are found by comparing them with KeyEqual one by one.
 	KeyEqual func(Key, Key) bool
+	// comparer (if not nil) is used to compare keys unless KeyEqual is set.
+	comparer EqualityComparer[Key]
+	// buckets maps keys' hashes (according to 'comparer') to indexes of groupings.
+	// buckets are maintained on each change even if KeyEqual is set,
+	// so that they stay valid if KeyEqual is reset to nil.
+	buckets map[uint64][]int
 }
 
+// keyEqual returns the equaler for the Lookup's keys.
+func (lk *Lookup[Key, Element]) keyEqual() func(Key, Key) bool {
+	if lk.KeyEqual != nil || lk.comparer == nil {
+		return lk.KeyEqual
+	}
+	return lk.comparer.Equal
+}
+
+// setComparer makes 'lk' compare keys with 'comparer' and rebuilds the buckets.
+func (lk *Lookup[Key, Element]) setComparer(comparer EqualityComparer[Key]) {
+	lk.comparer = comparer
+	lk.rehash()
+}
+
+// keyIndex returns the index of the grouping with 'key' or -1 if there is no such grouping.
+// keyIndex does not modify 'lk', so it is safe for concurrent use by readers.
 func (lk *Lookup[Key, Element]) keyIndex(key Key) int {
-	return slices.IndexFunc(lk.groupings,
-		func(g Grouping[Key, Element]) bool { return lk.KeyEqual(g.key, key) })
+	if lk.KeyEqual != nil || lk.comparer == nil {
+		return slices.IndexFunc(lk.groupings,
+			func(g Grouping[Key, Element]) bool { return lk.KeyEqual(g.key, key) })
+	}
+	for _, i := range lk.buckets[lk.comparer.Hash(key)] {
+		if lk.comparer.Equal(lk.groupings[i].key, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// appendGrouping appends 'g' to the groupings of 'lk'.
+func (lk *Lookup[Key, Element]) appendGrouping(g Grouping[Key, Element]) {
+	if lk.comparer != nil {
+		if lk.buckets == nil {
+			lk.buckets = make(map[uint64][]int)
+		}
+		h := lk.comparer.Hash(g.key)
+		lk.buckets[h] = append(lk.buckets[h], len(lk.groupings))
+	}
+	lk.groupings = append(lk.groupings, g)
+}
+
+// rehash rebuilds the buckets of 'lk'.
+func (lk *Lookup[Key, Element]) rehash() {
+	lk.buckets = nil
+	if lk.comparer == nil {
+		return
+	}
+	lk.buckets = make(map[uint64][]int)
+	for i, g := range lk.groupings {
+		h := lk.comparer.Hash(g.key)
+		lk.buckets[h] = append(lk.buckets[h], i)
+	}
 }
 
 // Add adds element 'el' with specified 'key' to 'lk'
@@ -26,8 +87,7 @@ func (lk *Lookup[Key, Element]) Add(key Key, el Element) {
 	if i >= 0 {
 		lk.groupings[i].values = append(lk.groupings[i].values, el)
 	} else {
-		gr := Grouping[Key, Element]{key: key, values: []Element{el}}
-		lk.groupings = append(lk.groupings, gr)
+		lk.appendGrouping(Grouping[Key, Element]{key: key, values: []Element{el}})
 	}
 }
 
@@ -61,6 +121,87 @@ func (lk *Lookup[Key, Element]) Item(key Key) iter.Seq[Element] {
 	return SliceAll(lk.itemSlice(key))
 }
 
+// ItemCount returns the number of values indexed by a specified key.
+// If 'key' is not in the Lookup, 0 is returned.
+func (lk *Lookup[Key, Element]) ItemCount(key Key) int {
+	return len(lk.itemSlice(key))
+}
+
+// Keys returns a sequence of the Lookup's keys in the groupings' order.
+func (lk *Lookup[Key, Element]) Keys() iter.Seq[Key] {
+	return func(yield func(Key) bool) {
+		for _, g := range lk.groupings {
+			if !yield(g.key) {
+				return
+			}
+		}
+	}
+}
+
+// All returns a sequence2 of the Lookup's keys and corresponding values in the groupings' order.
+func (lk *Lookup[Key, Element]) All() iter.Seq2[Key, iter.Seq[Element]] {
+	return func(yield func(Key, iter.Seq[Element]) bool) {
+		for _, g := range lk.groupings {
+			if !yield(g.key, g.Values()) {
+				return
+			}
+		}
+	}
+}
+
+// Groupings returns a sequence of the Lookup's groupings.
+func (lk *Lookup[Key, Element]) Groupings() iter.Seq[Grouping[Key, Element]] {
+	return func(yield func(Grouping[Key, Element]) bool) {
+		for _, g := range lk.groupings {
+			if !yield(g) {
+				return
+			}
+		}
+	}
+}
+
+// Remove removes a specified key and its values from the Lookup.
+// Remove returns false if 'key' is not in the Lookup.
+func (lk *Lookup[Key, Element]) Remove(key Key) bool {
+	i := lk.keyIndex(key)
+	if i < 0 {
+		return false
+	}
+	if lk.comparer != nil {
+		removeBucketIndex(lk.buckets, lk.comparer.Hash(lk.groupings[i].key), i)
+	}
+	lk.groupings = slices.Delete(lk.groupings, i, i+1)
+	return true
+}
+
+// Merge adds all keys and values from 'other' to the Lookup.
+// Keys are compared using the Lookup's KeyEqual.
+func (lk *Lookup[Key, Element]) Merge(other *Lookup[Key, Element]) {
+	if other == nil || other == lk {
+		return
+	}
+	for _, g := range other.groupings {
+		i := lk.keyIndex(g.key)
+		if i >= 0 {
+			lk.groupings[i].values = append(lk.groupings[i].values, g.values...)
+		} else {
+			lk.appendGrouping(Grouping[Key, Element]{key: g.key, values: slices.Clone(g.values)})
+		}
+	}
+}
+
+// Clone returns a copy of the Lookup.
+// Keys and values themselves are copied by assignment.
+func (lk *Lookup[Key, Element]) Clone() *Lookup[Key, Element] {
+	gg := make([]Grouping[Key, Element], len(lk.groupings))
+	for i, g := range lk.groupings {
+		gg[i] = Grouping[Key, Element]{key: g.key, values: slices.Clone(g.values)}
+	}
+	clone := &Lookup[Key, Element]{groupings: gg, KeyEqual: lk.KeyEqual, comparer: lk.comparer}
+	clone.rehash()
+	return clone
+}
+
 // EqualTo determines whether the current Lookup is equal to a specified Lookup.
 // Keys equality comparers do not participate in equality verification,
 // since non-nil funcs are always not deeply equal.
@@ -68,25 +209,41 @@ func (lk *Lookup[Key, Element]) EqualTo(lk2 *Lookup[Key, Element]) bool {
 	if lk.Count() != lk2.Count() {
 		return false
 	}
+	equal := lk.keyEqual()
 	for i, g := range lk.groupings {
 		g2 := lk2.groupings[i]
-		if !lk.KeyEqual(g.key, g2.key) || !reflect.DeepEqual(g.values, g2.values) {
+		if !equal(g.key, g2.key) || !reflect.DeepEqual(g.values, g2.values) {
 			return false
 		}
 	}
 	return true
 }
 
+// LookupToMap creates a [map] from a [Lookup] with comparable keys.
+// Each key is mapped to a slice of its values.
+//
+// [map]: https://go.dev/ref/spec#Map_types
+func LookupToMap[Key comparable, Element any](lookup *Lookup[Key, Element]) (map[Key][]Element, error) {
+	if lookup == nil {
+		return nil, newOperatorError("LookupToMap", "lookup", ErrNilSource)
+	}
+	m := make(map[Key][]Element, len(lookup.groupings))
+	for _, g := range lookup.groupings {
+		m[g.key] = append(m[g.key], g.values...)
+	}
+	return m, nil
+}
+
 // [ApplyResultSelector] applies a transform function to each key and its associated values and returns the results.
 //
 // [ApplyResultSelector]: https://learn.microsoft.com/dotnet/api/system.linq.lookup-2.applyresultselector
 func ApplyResultSelector[Key, Element, Result any](lookup *Lookup[Key, Element],
 	resultSelector func(Key, iter.Seq[Element]) Result) (iter.Seq[Result], error) {
 	if lookup == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("ApplyResultSelector", "lookup", ErrNilSource)
 	}
 	if resultSelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("ApplyResultSelector", "resultSelector", ErrNilSelector)
 	}
 	return func(yield func(Result) bool) {
 			for _, g := range lookup.groupings {
@@ -97,3 +254,82 @@ func ApplyResultSelector[Key, Element, Result any](lookup *Lookup[Key, Element],
 		},
 		nil
 }
+
+func (lk Lookup[Key, Element]) data() []groupingData[Key, Element] {
+	gg := make([]groupingData[Key, Element], len(lk.groupings))
+	for i, g := range lk.groupings {
+		gg[i] = g.data()
+	}
+	return gg
+}
+
+// setData replaces contents of 'lk' with 'gg'.
+// If 'lk' has neither KeyEqual nor a comparer, [DeepComparer] is used.
+// Groupings with equal (according to the Lookup's key equaler) keys are merged.
+func (lk *Lookup[Key, Element]) setData(gg []groupingData[Key, Element]) {
+	lk.groupings = make([]Grouping[Key, Element], 0, len(gg))
+	if lk.KeyEqual == nil && lk.comparer == nil {
+		lk.comparer = DeepComparer[Key]()
+	}
+	lk.rehash()
+	for _, g := range gg {
+		i := lk.keyIndex(g.Key)
+		if i >= 0 {
+			lk.groupings[i].values = append(lk.groupings[i].values, g.Values...)
+		} else {
+			lk.appendGrouping(Grouping[Key, Element]{key: g.Key, values: g.Values})
+		}
+	}
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// Lookup is encoded as JSON array of its groupings (see [Grouping.MarshalJSON]) in the groupings' order.
+// KeyEqual is not encoded.
+func (lk Lookup[Key, Element]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(lk.data())
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// KeyEqual and comparer of 'lk' are retained (if 'lk' has neither, [DeepComparer] is used),
+// so KeyEqual must be set before decoding, if custom key equaler is needed (see also [UnmarshalLookupJSON]).
+func (lk *Lookup[Key, Element]) UnmarshalJSON(data []byte) error {
+	var gg []groupingData[Key, Element]
+	if err := json.Unmarshal(data, &gg); err != nil {
+		return err
+	}
+	lk.setData(gg)
+	return nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface using [gob] encoding.
+// So Lookup may be also encoded with [gob.Encoder]. KeyEqual is not encoded.
+func (lk Lookup[Key, Element]) MarshalBinary() ([]byte, error) {
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(lk.data()); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// KeyEqual of 'lk' is treated the same way as by [Lookup.UnmarshalJSON].
+func (lk *Lookup[Key, Element]) UnmarshalBinary(data []byte) error {
+	var gg []groupingData[Key, Element]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&gg); err != nil {
+		return err
+	}
+	lk.setData(gg)
+	return nil
+}
+
+// UnmarshalLookupJSON decodes a [Lookup] encoded by [Lookup.MarshalJSON] using a specified key equaler.
+func UnmarshalLookupJSON[Key, Element any](data []byte, equal func(Key, Key) bool) (*Lookup[Key, Element], error) {
+	if equal == nil {
+		return nil, newOperatorError("UnmarshalLookupJSON", "equal", ErrNilEqual)
+	}
+	lk := &Lookup[Key, Element]{KeyEqual: equal}
+	if err := lk.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return lk, nil
+}
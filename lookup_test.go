@@ -1,8 +1,15 @@
 package go2linq
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"iter"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/solsw/generichelper"
@@ -56,7 +63,7 @@ func TestApplyResultSelector(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("ApplyResultSelector() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -68,3 +75,246 @@ func TestApplyResultSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestLookup_JSON(t *testing.T) {
+	lk := Lookup[string, int]{KeyEqual: generichelper.DeepEqual[string]}
+	lk.Add("b", 1)
+	lk.Add("a", 2)
+	lk.Add("b", 3)
+	lk.Add("B", 4)
+	data, err := json.Marshal(&lk)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	wantJSON := `[{"key":"b","values":[1,3]},{"key":"a","values":[2]},{"key":"B","values":[4]}]`
+	if string(data) != wantJSON {
+		t.Errorf("json.Marshal() = %s, want %s", data, wantJSON)
+	}
+	var got Lookup[string, int]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !got.EqualTo(&lk) {
+		t.Errorf("json.Unmarshal() = %v, want %v", got, lk)
+	}
+
+	// custom key equaler merges groupings
+	got2, err := UnmarshalLookupJSON[string, int](data, caseInsensitiveEqual)
+	if err != nil {
+		t.Fatalf("UnmarshalLookupJSON() error = %v", err)
+	}
+	want2 := Lookup[string, int]{KeyEqual: caseInsensitiveEqual}
+	want2.Add("b", 1)
+	want2.Add("b", 3)
+	want2.Add("b", 4)
+	want2.Add("a", 2)
+	if !got2.EqualTo(&want2) {
+		t.Errorf("UnmarshalLookupJSON() = %v, want %v", got2, want2)
+	}
+	if s := StringDef(got2.Item("B")); s != "[1 3 4]" {
+		t.Errorf("UnmarshalLookupJSON().Item(\"B\") = %v, want [1 3 4]", s)
+	}
+
+	if _, err := UnmarshalLookupJSON[string, int](data, nil); !errors.Is(err, ErrNilEqual) {
+		t.Errorf("UnmarshalLookupJSON() error = %v, expectedErr %v", err, ErrNilEqual)
+	}
+	if _, err := UnmarshalLookupJSON[string, int]([]byte(`{"key":1}`), caseInsensitiveEqual); err == nil {
+		t.Errorf("UnmarshalLookupJSON() error = nil, want error")
+	}
+}
+
+func TestLookup_replacedKeyEqual(t *testing.T) {
+	lk, _ := ToLookup(VarAll("a", "b", "c"), Identity[string])
+	lk.KeyEqual = strings.EqualFold
+	lk.Add("A", "A")
+	if got := lk.Count(); got != 3 {
+		t.Errorf("Count() = %v, want 3", got)
+	}
+	if !lk.Contains("B") || lk.ItemCount("a") != 2 {
+		t.Errorf("Contains(\"B\") = %v, ItemCount(\"a\") = %v, want true, 2", lk.Contains("B"), lk.ItemCount("a"))
+	}
+	if !lk.Remove("C") || lk.Contains("c") {
+		t.Errorf("Remove(\"C\") failed")
+	}
+
+	// KeyEqual set before decoding into a Lookup created with a comparer
+	src := &Lookup[string, int]{KeyEqual: generichelper.DeepEqual[string]}
+	src.Add("x", 1)
+	src.Add("X", 2)
+	data, _ := json.Marshal(src)
+	lk3, _ := ToLookup(VarAll(0), func(int) string { return "y" })
+	lk3.KeyEqual = strings.EqualFold
+	if err := json.Unmarshal(data, lk3); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got := StringDef(lk3.Item("X")); lk3.Count() != 1 || got != "[1 2]" {
+		t.Errorf("json.Unmarshal() = %v, want one grouping [1 2]", got)
+	}
+}
+
+func TestLookup_concurrentReaders(t *testing.T) {
+	lk, _ := ToLookup(RangeMust(0, 1000), func(i int) int { return i % 100 })
+	var wg sync.WaitGroup
+	for r := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range 100 {
+				if !lk.Contains(key) || lk.ItemCount(key) != 10 || lk.Contains(key+100*(r+1)) {
+					t.Errorf("Lookup readers disagree for key %d", key)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLookup_gob(t *testing.T) {
+	type cached struct {
+		Name   string
+		Lookup *Lookup[int, string]
+	}
+	lk, _ := ToLookup(VarAll("one", "two", "three", "four", "five", ""), func(s string) int { return len(s) })
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(cached{Name: "byLen", Lookup: lk}); err != nil {
+		t.Fatalf("gob.Encode() error = %v", err)
+	}
+	var got cached
+	if err := gob.NewDecoder(&b).Decode(&got); err != nil {
+		t.Fatalf("gob.Decode() error = %v", err)
+	}
+	if got.Name != "byLen" || !got.Lookup.EqualTo(lk) {
+		t.Errorf("gob.Decode() = %v, want %v", got.Lookup, lk)
+	}
+	if s := StringDef(got.Lookup.Item(3)); s != "[one two]" {
+		t.Errorf("gob.Decode().Item(3) = %v, want [one two]", s)
+	}
+}
+
+func ExampleLookup_MarshalJSON() {
+	lookup, _ := ToLookupSel(
+		VarAll("apple", "avocado", "banana", "blueberry", "cherry"),
+		func(s string) string { return s[:1] },
+		func(s string) int { return len(s) },
+	)
+	data, _ := json.Marshal(lookup)
+	fmt.Println(string(data))
+	// Output:
+	// [{"key":"a","values":[5,7]},{"key":"b","values":[6,9]},{"key":"c","values":[6]}]
+}
+
+func newTestLookup() *Lookup[int, string] {
+	lk := &Lookup[int, string]{KeyEqual: generichelper.DeepEqual[int]}
+	lk.Add(3, "abc")
+	lk.Add(1, "x")
+	lk.Add(3, "def")
+	lk.Add(2, "00")
+	lk.Add(1, "y")
+	return lk
+}
+
+func TestLookup_KeysAllGroupings(t *testing.T) {
+	lk := newTestLookup()
+	if got := StringDef(lk.Keys()); got != "[3 1 2]" {
+		t.Errorf("Keys() = %v, want [3 1 2]", got)
+	}
+	var all []string
+	for k, vv := range lk.All() {
+		all = append(all, fmt.Sprintf("%d:%s", k, StringDef(vv)))
+	}
+	if got := fmt.Sprint(all); got != "[3:[abc def] 1:[x y] 2:[00]]" {
+		t.Errorf("All() = %v, want [3:[abc def] 1:[x y] 2:[00]]", got)
+	}
+	first, _ := First(lk.Groupings())
+	if first.Key() != 3 || StringDef(first.Values()) != "[abc def]" {
+		t.Errorf("Groupings() first = %v", first)
+	}
+	take, _ := Take(lk.Keys(), 2)
+	if got := StringDef(take); got != "[3 1]" {
+		t.Errorf("Take(Keys()) = %v, want [3 1]", got)
+	}
+}
+
+func TestLookup_ItemCount(t *testing.T) {
+	lk := newTestLookup()
+	tests := []struct {
+		key  int
+		want int
+	}{
+		{key: 3, want: 2},
+		{key: 2, want: 1},
+		{key: 5, want: 0},
+	}
+	for _, tt := range tests {
+		if got := lk.ItemCount(tt.key); got != tt.want {
+			t.Errorf("ItemCount(%d) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestLookup_Remove(t *testing.T) {
+	lk := newTestLookup()
+	if !lk.Remove(1) {
+		t.Errorf("Remove(1) = false, want true")
+	}
+	if lk.Remove(1) {
+		t.Errorf("second Remove(1) = true, want false")
+	}
+	if got := StringDef(lk.Keys()); got != "[3 2]" || lk.Contains(1) {
+		t.Errorf("Keys() after Remove(1) = %v, want [3 2]", got)
+	}
+	// Lookup with hash buckets
+	hlk, _ := ToLookup(VarAll(1, 2, 3, 4, 5, 2, 4), func(i int) int { return i % 10 })
+	if !hlk.Remove(2) || hlk.Remove(2) {
+		t.Errorf("Remove(2) on hashed Lookup failed")
+	}
+	for _, key := range []int{1, 3, 4, 5} {
+		if !hlk.Contains(key) {
+			t.Errorf("Contains(%d) after Remove(2) = false, want true", key)
+		}
+	}
+	hlk.Add(4, 4)
+	if got := hlk.ItemCount(4); got != 3 || hlk.Count() != 4 {
+		t.Errorf("ItemCount(4) after Remove(2) = %v, want 3", got)
+	}
+}
+
+func TestLookup_MergeClone(t *testing.T) {
+	lk := newTestLookup()
+	clone := lk.Clone()
+	if !clone.EqualTo(lk) {
+		t.Errorf("Clone() = %v, want %v", clone, lk)
+	}
+	other := Lookup[int, string]{KeyEqual: generichelper.DeepEqual[int]}
+	other.Add(2, "11")
+	other.Add(4, "z")
+	lk.Merge(&other)
+	lk.Merge(nil)
+	want := newTestLookup()
+	want.Add(2, "11")
+	want.Add(4, "z")
+	if !lk.EqualTo(want) {
+		t.Errorf("Merge() = %v, want %v", lk, want)
+	}
+	// the clone is not affected by changes of the original
+	if clone.EqualTo(lk) || clone.ItemCount(2) != 1 {
+		t.Errorf("Clone() shares data with the original")
+	}
+	// the merged Lookup does not share data with 'other'
+	other.Add(4, "w")
+	if lk.ItemCount(4) != 1 {
+		t.Errorf("Merge() shares data with the other Lookup")
+	}
+}
+
+func TestLookupToMap(t *testing.T) {
+	if _, err := LookupToMap[int, string](nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("LookupToMap() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	got, _ := LookupToMap(newTestLookup())
+	want := map[int][]string{3: {"abc", "def"}, 1: {"x", "y"}, 2: {"00"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LookupToMap() = %v, want %v", got, want)
+	}
+}
@@ -39,7 +39,7 @@ func minMaxPrim[Source, Result any](source iter.Seq[Source], selector func(Sourc
 // [Min]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.min
 func Min[Source cmp.Ordered](source iter.Seq[Source]) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("Min", "source", ErrNilSource)
 	}
 	return MinSel(source, Identity[Source])
 }
@@ -49,10 +49,10 @@ func Min[Source cmp.Ordered](source iter.Seq[Source]) (Source, error) {
 // [MinLs]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.min
 func MinLs[Source any](source iter.Seq[Source], less func(Source, Source) bool) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("MinLs", "source", ErrNilSource)
 	}
 	if less == nil {
-		return generichelper.ZeroValue[Source](), ErrNilLess
+		return generichelper.ZeroValue[Source](), newOperatorError("MinLs", "less", ErrNilLess)
 	}
 	return MinSelLs(source, Identity[Source], less)
 }
@@ -62,10 +62,10 @@ func MinLs[Source any](source iter.Seq[Source], less func(Source, Source) bool)
 // [MinSel]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.min
 func MinSel[Source any, Result cmp.Ordered](source iter.Seq[Source], selector func(Source) Result) (Result, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Result](), ErrNilSource
+		return generichelper.ZeroValue[Result](), newOperatorError("MinSel", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return generichelper.ZeroValue[Result](), ErrNilSelector
+		return generichelper.ZeroValue[Result](), newOperatorError("MinSel", "selector", ErrNilSelector)
 	}
 	return MinSelLs(source, selector, cmp.Less[Result])
 }
@@ -76,17 +76,17 @@ func MinSel[Source any, Result cmp.Ordered](source iter.Seq[Source], selector fu
 // [MinSelLs]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.min
 func MinSelLs[Source, Result any](source iter.Seq[Source], selector func(Source) Result, less func(Result, Result) bool) (Result, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Result](), ErrNilSource
+		return generichelper.ZeroValue[Result](), newOperatorError("MinSelLs", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return generichelper.ZeroValue[Result](), ErrNilSelector
+		return generichelper.ZeroValue[Result](), newOperatorError("MinSelLs", "selector", ErrNilSelector)
 	}
 	if less == nil {
-		return generichelper.ZeroValue[Result](), ErrNilLess
+		return generichelper.ZeroValue[Result](), newOperatorError("MinSelLs", "less", ErrNilLess)
 	}
 	_, min, count := minMaxPrim(source, selector, less, true)
 	if count == 0 {
-		return generichelper.ZeroValue[Result](), ErrEmptySource
+		return generichelper.ZeroValue[Result](), newOperatorError("MinSelLs", "", ErrEmptySource)
 	}
 	return min, nil
 }
@@ -96,10 +96,10 @@ func MinSelLs[Source, Result any](source iter.Seq[Source], selector func(Source)
 // [MinBySel]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.minby
 func MinBySel[Source any, Key cmp.Ordered](source iter.Seq[Source], selector func(Source) Key) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("MinBySel", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSelector
+		return generichelper.ZeroValue[Source](), newOperatorError("MinBySel", "selector", ErrNilSelector)
 	}
 	return MinBySelLs(source, selector, cmp.Less[Key])
 }
@@ -109,17 +109,17 @@ func MinBySel[Source any, Key cmp.Ordered](source iter.Seq[Source], selector fun
 // [MinBySelLs]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.minby
 func MinBySelLs[Source, Key any](source iter.Seq[Source], selector func(Source) Key, less func(Key, Key) bool) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("MinBySelLs", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSelector
+		return generichelper.ZeroValue[Source](), newOperatorError("MinBySelLs", "selector", ErrNilSelector)
 	}
 	if less == nil {
-		return generichelper.ZeroValue[Source](), ErrNilLess
+		return generichelper.ZeroValue[Source](), newOperatorError("MinBySelLs", "less", ErrNilLess)
 	}
 	min, _, count := minMaxPrim(source, selector, less, true)
 	if count == 0 {
-		return generichelper.ZeroValue[Source](), ErrEmptySource
+		return generichelper.ZeroValue[Source](), newOperatorError("MinBySelLs", "", ErrEmptySource)
 	}
 	return min, nil
 }
@@ -129,7 +129,7 @@ func MinBySelLs[Source, Key any](source iter.Seq[Source], selector func(Source)
 // [Max]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.max
 func Max[Source cmp.Ordered](source iter.Seq[Source]) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("Max", "source", ErrNilSource)
 	}
 	return MaxSel(source, Identity[Source])
 }
@@ -139,10 +139,10 @@ func Max[Source cmp.Ordered](source iter.Seq[Source]) (Source, error) {
 // [MaxLs]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.max
 func MaxLs[Source any](source iter.Seq[Source], less func(Source, Source) bool) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("MaxLs", "source", ErrNilSource)
 	}
 	if less == nil {
-		return generichelper.ZeroValue[Source](), ErrNilLess
+		return generichelper.ZeroValue[Source](), newOperatorError("MaxLs", "less", ErrNilLess)
 	}
 	return MaxSelLs(source, Identity[Source], less)
 }
@@ -152,10 +152,10 @@ func MaxLs[Source any](source iter.Seq[Source], less func(Source, Source) bool)
 // [MaxSel]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.max
 func MaxSel[Source any, Result cmp.Ordered](source iter.Seq[Source], selector func(Source) Result) (Result, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Result](), ErrNilSource
+		return generichelper.ZeroValue[Result](), newOperatorError("MaxSel", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return generichelper.ZeroValue[Result](), ErrNilSelector
+		return generichelper.ZeroValue[Result](), newOperatorError("MaxSel", "selector", ErrNilSelector)
 	}
 	return MaxSelLs(source, selector, cmp.Less[Result])
 }
@@ -166,17 +166,17 @@ func MaxSel[Source any, Result cmp.Ordered](source iter.Seq[Source], selector fu
 // [MaxSelLs]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.max
 func MaxSelLs[Source, Result any](source iter.Seq[Source], selector func(Source) Result, less func(Result, Result) bool) (Result, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Result](), ErrNilSource
+		return generichelper.ZeroValue[Result](), newOperatorError("MaxSelLs", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return generichelper.ZeroValue[Result](), ErrNilSelector
+		return generichelper.ZeroValue[Result](), newOperatorError("MaxSelLs", "selector", ErrNilSelector)
 	}
 	if less == nil {
-		return generichelper.ZeroValue[Result](), ErrNilLess
+		return generichelper.ZeroValue[Result](), newOperatorError("MaxSelLs", "less", ErrNilLess)
 	}
 	_, max, count := minMaxPrim(source, selector, less, false)
 	if count == 0 {
-		return generichelper.ZeroValue[Result](), ErrEmptySource
+		return generichelper.ZeroValue[Result](), newOperatorError("MaxSelLs", "", ErrEmptySource)
 	}
 	return max, nil
 }
@@ -186,10 +186,10 @@ func MaxSelLs[Source, Result any](source iter.Seq[Source], selector func(Source)
 // [MaxBySel]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.maxby
 func MaxBySel[Source any, Key cmp.Ordered](source iter.Seq[Source], selector func(Source) Key) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("MaxBySel", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSelector
+		return generichelper.ZeroValue[Source](), newOperatorError("MaxBySel", "selector", ErrNilSelector)
 	}
 	return MaxBySelLs(source, selector, cmp.Less[Key])
 }
@@ -199,17 +199,17 @@ func MaxBySel[Source any, Key cmp.Ordered](source iter.Seq[Source], selector fun
 // [MaxBySelLs]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.maxby
 func MaxBySelLs[Source, Key any](source iter.Seq[Source], selector func(Source) Key, less func(Key, Key) bool) (Source, error) {
 	if source == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSource
+		return generichelper.ZeroValue[Source](), newOperatorError("MaxBySelLs", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return generichelper.ZeroValue[Source](), ErrNilSelector
+		return generichelper.ZeroValue[Source](), newOperatorError("MaxBySelLs", "selector", ErrNilSelector)
 	}
 	if less == nil {
-		return generichelper.ZeroValue[Source](), ErrNilLess
+		return generichelper.ZeroValue[Source](), newOperatorError("MaxBySelLs", "less", ErrNilLess)
 	}
 	max, _, count := minMaxPrim(source, selector, less, false)
 	if count == 0 {
-		return generichelper.ZeroValue[Source](), ErrEmptySource
+		return generichelper.ZeroValue[Source](), newOperatorError("MaxBySelLs", "", ErrEmptySource)
 	}
 	return max, nil
 }
@@ -0,0 +1,1712 @@
+// Code generated by mustgen. DO NOT EDIT.
+
+package go2linq
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"io"
+	"iter"
+	"math/rand/v2"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// AggregateMust is like [Aggregate] but panics in case of error.
+func AggregateMust[Source any](source iter.Seq[Source], accumulator func(Source, Source) Source) Source {
+	r0, err := Aggregate[Source](source, accumulator)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// AggregateSeedMust is like [AggregateSeed] but panics in case of error.
+func AggregateSeedMust[Source, Accumulate any](source iter.Seq[Source], seed Accumulate, accumulator func(Accumulate, Source) Accumulate) Accumulate {
+	r0, err := AggregateSeed[Source, Accumulate](source, seed, accumulator)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// AggregateSeedSelMust is like [AggregateSeedSel] but panics in case of error.
+func AggregateSeedSelMust[Source, Accumulate, Result any](source iter.Seq[Source], seed Accumulate, accumulator func(Accumulate, Source) Accumulate, resultSelector func(Accumulate) Result) Result {
+	r0, err := AggregateSeedSel[Source, Accumulate, Result](source, seed, accumulator, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// AllMust is like [All] but panics in case of error.
+func AllMust[Source any](source iter.Seq[Source], predicate func(Source) bool) bool {
+	r0, err := All[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// AnyMust is like [Any] but panics in case of error.
+func AnyMust[Source any](source iter.Seq[Source]) bool {
+	r0, err := Any[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// AnyPredMust is like [AnyPred] but panics in case of error.
+func AnyPredMust[Source any](source iter.Seq[Source], predicate func(Source) bool) bool {
+	r0, err := AnyPred[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// AppendMust is like [Append] but panics in case of error.
+func AppendMust[Source any](source iter.Seq[Source], element Source) iter.Seq[Source] {
+	r0, err := Append[Source](source, element)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ApplyResultSelectorMust is like [ApplyResultSelector] but panics in case of error.
+func ApplyResultSelectorMust[Key, Element, Result any](lookup *Lookup[Key, Element], resultSelector func(Key, iter.Seq[Element]) Result) iter.Seq[Result] {
+	r0, err := ApplyResultSelector[Key, Element, Result](lookup, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// AverageMust is like [Average] but panics in case of error.
+func AverageMust[Source constraints.Integer | constraints.Float](source iter.Seq[Source]) float64 {
+	r0, err := Average[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// AverageSelMust is like [AverageSel] but panics in case of error.
+func AverageSelMust[Source any, Result constraints.Integer | constraints.Float](source iter.Seq[Source], selector func(Source) Result) float64 {
+	r0, err := AverageSel[Source, Result](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// CSVRecordsMust is like [CSVRecords] but panics in case of error.
+func CSVRecordsMust(r io.Reader, opts *CSVOptions) iter.Seq2[[]string, error] {
+	r0, err := CSVRecords(r, opts)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// CastMust is like [Cast] but panics in case of error.
+func CastMust[Source, Result any](source iter.Seq[Source]) iter.Seq[Result] {
+	r0, err := Cast[Source, Result](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// CastErrMust is like [CastErr] but panics in case of error.
+func CastErrMust[Source, Result any](source iter.Seq[Source]) iter.Seq2[Result, error] {
+	r0, err := CastErr[Source, Result](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ChunkMust is like [Chunk] but panics in case of error.
+func ChunkMust[Source any](source iter.Seq[Source], size int) iter.Seq[[]Source] {
+	r0, err := Chunk[Source](source, size)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ChunkTimeoutMust is like [ChunkTimeout] but panics in case of error.
+func ChunkTimeoutMust[Source any](ctx context.Context, source iter.Seq[Source], size int, maxWait time.Duration) iter.Seq[[]Source] {
+	r0, err := ChunkTimeout[Source](ctx, source, size, maxWait)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ChunkTimeoutClockMust is like [ChunkTimeoutClock] but panics in case of error.
+func ChunkTimeoutClockMust[Source any](ctx context.Context, source iter.Seq[Source], size int, maxWait time.Duration, clock Clock) iter.Seq[[]Source] {
+	r0, err := ChunkTimeoutClock[Source](ctx, source, size, maxWait, clock)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ConcatMust is like [Concat] but panics in case of error.
+func ConcatMust[Source any](first iter.Seq[Source], second iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := Concat[Source](first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ContainsMust is like [Contains] but panics in case of error.
+func ContainsMust[Source any](source iter.Seq[Source], value Source) bool {
+	r0, err := Contains[Source](source, value)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ContainsEqMust is like [ContainsEq] but panics in case of error.
+func ContainsEqMust[Source any](source iter.Seq[Source], value Source, equal func(Source, Source) bool) bool {
+	r0, err := ContainsEq[Source](source, value, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ConvertMust is like [Convert] but panics in case of error.
+func ConvertMust[From, To Number](source iter.Seq[From]) iter.Seq2[To, error] {
+	r0, err := Convert[From, To](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// CountMust is like [Count] but panics in case of error.
+func CountMust[Source any](source iter.Seq[Source]) int {
+	r0, err := Count[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// CountPredMust is like [CountPred] but panics in case of error.
+func CountPredMust[Source any](source iter.Seq[Source], predicate func(Source) bool) int {
+	r0, err := CountPred[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// CycleMust is like [Cycle] but panics in case of error.
+func CycleMust[Source any](source iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := Cycle[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DefaultIfEmptyMust is like [DefaultIfEmpty] but panics in case of error.
+func DefaultIfEmptyMust[Source any](source iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := DefaultIfEmpty[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DefaultIfEmptyDefMust is like [DefaultIfEmptyDef] but panics in case of error.
+func DefaultIfEmptyDefMust[Source any](source iter.Seq[Source], defaultValue Source) iter.Seq[Source] {
+	r0, err := DefaultIfEmptyDef[Source](source, defaultValue)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DiffMust is like [Diff] but panics in case of error.
+func DiffMust[T any](first iter.Seq[T], second iter.Seq[T], equal func(T, T) bool) iter.Seq[Edit[T]] {
+	r0, err := Diff[T](first, second, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DiffByMust is like [DiffBy] but panics in case of error.
+func DiffByMust[T, Key any](first iter.Seq[T], second iter.Seq[T], keySelector func(T) Key, equal func(T, T) bool) iter.Seq[Change[Key, T]] {
+	r0, err := DiffBy[T, Key](first, second, keySelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DistinctMust is like [Distinct] but panics in case of error.
+func DistinctMust[Source any](source iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := Distinct[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DistinctByMust is like [DistinctBy] but panics in case of error.
+func DistinctByMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key) iter.Seq[Source] {
+	r0, err := DistinctBy[Source, Key](source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DistinctByCmpMust is like [DistinctByCmp] but panics in case of error.
+func DistinctByCmpMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, compare func(Key, Key) int) iter.Seq[Source] {
+	r0, err := DistinctByCmp[Source, Key](source, keySelector, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DistinctByEqMust is like [DistinctByEq] but panics in case of error.
+func DistinctByEqMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, equal func(Key, Key) bool) iter.Seq[Source] {
+	r0, err := DistinctByEq[Source, Key](source, keySelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DistinctCmpMust is like [DistinctCmp] but panics in case of error.
+func DistinctCmpMust[Source any](source iter.Seq[Source], compare func(Source, Source) int) iter.Seq[Source] {
+	r0, err := DistinctCmp[Source](source, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DistinctComparerMust is like [DistinctComparer] but panics in case of error.
+func DistinctComparerMust[Source any](source iter.Seq[Source], comparer EqualityComparer[Source]) iter.Seq[Source] {
+	r0, err := DistinctComparer[Source](source, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// DistinctEqMust is like [DistinctEq] but panics in case of error.
+func DistinctEqMust[Source any](source iter.Seq[Source], equal func(Source, Source) bool) iter.Seq[Source] {
+	r0, err := DistinctEq[Source](source, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ElementAtMust is like [ElementAt] but panics in case of error.
+func ElementAtMust[Source any](source iter.Seq[Source], index int) Source {
+	r0, err := ElementAt[Source](source, index)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ElementAtOrDefaultMust is like [ElementAtOrDefault] but panics in case of error.
+func ElementAtOrDefaultMust[Source any](source iter.Seq[Source], index int) Source {
+	r0, err := ElementAtOrDefault[Source](source, index)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ExceptMust is like [Except] but panics in case of error.
+func ExceptMust[Source any](first iter.Seq[Source], second iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := Except[Source](first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ExceptByMust is like [ExceptBy] but panics in case of error.
+func ExceptByMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], keySelector func(Source) Key) iter.Seq[Source] {
+	r0, err := ExceptBy[Source, Key](first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ExceptByCmpMust is like [ExceptByCmp] but panics in case of error.
+func ExceptByCmpMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], keySelector func(Source) Key, compare func(Key, Key) int) iter.Seq[Source] {
+	r0, err := ExceptByCmp[Source, Key](first, second, keySelector, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ExceptByEqMust is like [ExceptByEq] but panics in case of error.
+func ExceptByEqMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], keySelector func(Source) Key, equal func(Key, Key) bool) iter.Seq[Source] {
+	r0, err := ExceptByEq[Source, Key](first, second, keySelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ExceptCmpMust is like [ExceptCmp] but panics in case of error.
+func ExceptCmpMust[Source any](first iter.Seq[Source], second iter.Seq[Source], compare func(Source, Source) int) iter.Seq[Source] {
+	r0, err := ExceptCmp[Source](first, second, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ExceptEqMust is like [ExceptEq] but panics in case of error.
+func ExceptEqMust[Source any](first iter.Seq[Source], second iter.Seq[Source], equal func(Source, Source) bool) iter.Seq[Source] {
+	r0, err := ExceptEq[Source](first, second, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// FieldSelectorMust is like [FieldSelector] but panics in case of error.
+func FieldSelectorMust[T any](path string) func(T) any {
+	r0, err := FieldSelector[T](path)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// FirstMust is like [First] but panics in case of error.
+func FirstMust[Source any](source iter.Seq[Source]) Source {
+	r0, err := First[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// FirstOrDefaultMust is like [FirstOrDefault] but panics in case of error.
+func FirstOrDefaultMust[Source any](source iter.Seq[Source]) Source {
+	r0, err := FirstOrDefault[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// FirstOrDefaultPredMust is like [FirstOrDefaultPred] but panics in case of error.
+func FirstOrDefaultPredMust[Source any](source iter.Seq[Source], predicate func(Source) bool) Source {
+	r0, err := FirstOrDefaultPred[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// FirstPredMust is like [FirstPred] but panics in case of error.
+func FirstPredMust[Source any](source iter.Seq[Source], predicate func(Source) bool) Source {
+	r0, err := FirstPred[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ForEachMust is like [ForEach] but panics in case of error.
+func ForEachMust[T any](ctx context.Context, seq iter.Seq[T], action func(T) error) {
+	if err := ForEach[T](ctx, seq, action); err != nil {
+		panic(err)
+	}
+}
+
+// ForEachConcurrentMust is like [ForEachConcurrent] but panics in case of error.
+func ForEachConcurrentMust[T any](ctx context.Context, seq iter.Seq[T], action func(T) error) {
+	if err := ForEachConcurrent[T](ctx, seq, action); err != nil {
+		panic(err)
+	}
+}
+
+// GenerateMust is like [Generate] but panics in case of error.
+func GenerateMust[T any](seed T, next func(T) (T, bool)) iter.Seq[T] {
+	r0, err := Generate[T](seed, next)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupByMust is like [GroupBy] but panics in case of error.
+func GroupByMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key) iter.Seq[Grouping[Key, Source]] {
+	r0, err := GroupBy[Source, Key](source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupByComparerMust is like [GroupByComparer] but panics in case of error.
+func GroupByComparerMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, comparer EqualityComparer[Key]) iter.Seq[Grouping[Key, Source]] {
+	r0, err := GroupByComparer[Source, Key](source, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupByEqMust is like [GroupByEq] but panics in case of error.
+func GroupByEqMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, equal func(Key, Key) bool) iter.Seq[Grouping[Key, Source]] {
+	r0, err := GroupByEq[Source, Key](source, keySelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupByFieldMust is like [GroupByField] but panics in case of error.
+func GroupByFieldMust[Source any](source iter.Seq[Source], path string) iter.Seq[Grouping[any, Source]] {
+	r0, err := GroupByField[Source](source, path)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupByResMust is like [GroupByRes] but panics in case of error.
+func GroupByResMust[Source, Key, Result any](source iter.Seq[Source], keySelector func(Source) Key, resultSelector func(Key, iter.Seq[Source]) Result) iter.Seq[Result] {
+	r0, err := GroupByRes[Source, Key, Result](source, keySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupByResEqMust is like [GroupByResEq] but panics in case of error.
+func GroupByResEqMust[Source, Key, Result any](source iter.Seq[Source], keySelector func(Source) Key, resultSelector func(Key, iter.Seq[Source]) Result, equal func(Key, Key) bool) iter.Seq[Result] {
+	r0, err := GroupByResEq[Source, Key, Result](source, keySelector, resultSelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupBySelMust is like [GroupBySel] but panics in case of error.
+func GroupBySelMust[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element) iter.Seq[Grouping[Key, Element]] {
+	r0, err := GroupBySel[Source, Key, Element](source, keySelector, elementSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupBySelComparerMust is like [GroupBySelComparer] but panics in case of error.
+func GroupBySelComparerMust[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element, comparer EqualityComparer[Key]) iter.Seq[Grouping[Key, Element]] {
+	r0, err := GroupBySelComparer[Source, Key, Element](source, keySelector, elementSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupBySelEqMust is like [GroupBySelEq] but panics in case of error.
+func GroupBySelEqMust[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element, equal func(Key, Key) bool) iter.Seq[Grouping[Key, Element]] {
+	r0, err := GroupBySelEq[Source, Key, Element](source, keySelector, elementSelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupBySelResMust is like [GroupBySelRes] but panics in case of error.
+func GroupBySelResMust[Source, Key, Element, Result any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element, resultSelector func(Key, iter.Seq[Element]) Result) iter.Seq[Result] {
+	r0, err := GroupBySelRes[Source, Key, Element, Result](source, keySelector, elementSelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupBySelResEqMust is like [GroupBySelResEq] but panics in case of error.
+func GroupBySelResEqMust[Source, Key, Element, Result any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element, resultSelector func(Key, iter.Seq[Element]) Result, equal func(Key, Key) bool) iter.Seq[Result] {
+	r0, err := GroupBySelResEq[Source, Key, Element, Result](source, keySelector, elementSelector, resultSelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupJoinMust is like [GroupJoin] but panics in case of error.
+func GroupJoinMust[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner], outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(Outer, iter.Seq[Inner]) Result) iter.Seq[Result] {
+	r0, err := GroupJoin[Outer, Inner, Key, Result](outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// GroupJoinEqMust is like [GroupJoinEq] but panics in case of error.
+func GroupJoinEqMust[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner], outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(Outer, iter.Seq[Inner]) Result, equal func(Key, Key) bool) iter.Seq[Result] {
+	r0, err := GroupJoinEq[Outer, Inner, Key, Result](outer, inner, outerKeySelector, innerKeySelector, resultSelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// IntersectMust is like [Intersect] but panics in case of error.
+func IntersectMust[Source any](first iter.Seq[Source], second iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := Intersect[Source](first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// IntersectByMust is like [IntersectBy] but panics in case of error.
+func IntersectByMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], keySelector func(Source) Key) iter.Seq[Source] {
+	r0, err := IntersectBy[Source, Key](first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// IntersectByCmpMust is like [IntersectByCmp] but panics in case of error.
+func IntersectByCmpMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], keySelector func(Source) Key, compare func(Key, Key) int) iter.Seq[Source] {
+	r0, err := IntersectByCmp[Source, Key](first, second, keySelector, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// IntersectByEqMust is like [IntersectByEq] but panics in case of error.
+func IntersectByEqMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Key], keySelector func(Source) Key, equal func(Key, Key) bool) iter.Seq[Source] {
+	r0, err := IntersectByEq[Source, Key](first, second, keySelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// IntersectCmpMust is like [IntersectCmp] but panics in case of error.
+func IntersectCmpMust[Source any](first iter.Seq[Source], second iter.Seq[Source], compare func(Source, Source) int) iter.Seq[Source] {
+	r0, err := IntersectCmp[Source](first, second, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// IntersectEqMust is like [IntersectEq] but panics in case of error.
+func IntersectEqMust[Source any](first iter.Seq[Source], second iter.Seq[Source], equal func(Source, Source) bool) iter.Seq[Source] {
+	r0, err := IntersectEq[Source](first, second, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// IterateMust is like [Iterate] but panics in case of error.
+func IterateMust[T any](seed T, next func(T) T) iter.Seq[T] {
+	r0, err := Iterate[T](seed, next)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// JSONArrayMust is like [JSONArray] but panics in case of error.
+func JSONArrayMust[T any](r io.Reader) iter.Seq2[T, error] {
+	r0, err := JSONArray[T](r)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// JSONStreamMust is like [JSONStream] but panics in case of error.
+func JSONStreamMust[T any](r io.Reader) iter.Seq2[T, error] {
+	r0, err := JSONStream[T](r)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// JSONValuesMust is like [JSONValues] but panics in case of error.
+func JSONValuesMust[T any](r io.Reader) iter.Seq2[T, error] {
+	r0, err := JSONValues[T](r)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// JoinMust is like [Join] but panics in case of error.
+func JoinMust[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner], outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result) iter.Seq[Result] {
+	r0, err := Join[Outer, Inner, Key, Result](outer, inner, outerKeySelector, innerKeySelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// JoinComparerMust is like [JoinComparer] but panics in case of error.
+func JoinComparerMust[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner], outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, comparer EqualityComparer[Key]) iter.Seq[Result] {
+	r0, err := JoinComparer[Outer, Inner, Key, Result](outer, inner, outerKeySelector, innerKeySelector, resultSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// JoinEqMust is like [JoinEq] but panics in case of error.
+func JoinEqMust[Outer, Inner, Key, Result any](outer iter.Seq[Outer], inner iter.Seq[Inner], outerKeySelector func(Outer) Key, innerKeySelector func(Inner) Key, resultSelector func(Outer, Inner) Result, equal func(Key, Key) bool) iter.Seq[Result] {
+	r0, err := JoinEq[Outer, Inner, Key, Result](outer, inner, outerKeySelector, innerKeySelector, resultSelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// KeysetPageMust is like [KeysetPage] but panics in case of error.
+func KeysetPageMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, after *Key, size int, compare func(Key, Key) int) KeysetPageResult[Source, Key] {
+	r0, err := KeysetPage[Source, Key](source, keySelector, after, size, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// LastMust is like [Last] but panics in case of error.
+func LastMust[Source any](source iter.Seq[Source]) Source {
+	r0, err := Last[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// LastOrDefaultMust is like [LastOrDefault] but panics in case of error.
+func LastOrDefaultMust[Source any](source iter.Seq[Source]) Source {
+	r0, err := LastOrDefault[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// LastOrDefaultPredMust is like [LastOrDefaultPred] but panics in case of error.
+func LastOrDefaultPredMust[Source any](source iter.Seq[Source], predicate func(Source) bool) Source {
+	r0, err := LastOrDefaultPred[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// LastPredMust is like [LastPred] but panics in case of error.
+func LastPredMust[Source any](source iter.Seq[Source], predicate func(Source) bool) Source {
+	r0, err := LastPred[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// LinesMust is like [Lines] but panics in case of error.
+func LinesMust(r io.Reader) iter.Seq2[string, error] {
+	r0, err := Lines(r)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// LookupToMapMust is like [LookupToMap] but panics in case of error.
+func LookupToMapMust[Key comparable, Element any](lookup *Lookup[Key, Element]) map[Key][]Element {
+	r0, err := LookupToMap[Key, Element](lookup)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MaxMust is like [Max] but panics in case of error.
+func MaxMust[Source cmp.Ordered](source iter.Seq[Source]) Source {
+	r0, err := Max[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MaxBySelMust is like [MaxBySel] but panics in case of error.
+func MaxBySelMust[Source any, Key cmp.Ordered](source iter.Seq[Source], selector func(Source) Key) Source {
+	r0, err := MaxBySel[Source, Key](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MaxBySelLsMust is like [MaxBySelLs] but panics in case of error.
+func MaxBySelLsMust[Source, Key any](source iter.Seq[Source], selector func(Source) Key, less func(Key, Key) bool) Source {
+	r0, err := MaxBySelLs[Source, Key](source, selector, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MaxLsMust is like [MaxLs] but panics in case of error.
+func MaxLsMust[Source any](source iter.Seq[Source], less func(Source, Source) bool) Source {
+	r0, err := MaxLs[Source](source, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MaxSelMust is like [MaxSel] but panics in case of error.
+func MaxSelMust[Source any, Result cmp.Ordered](source iter.Seq[Source], selector func(Source) Result) Result {
+	r0, err := MaxSel[Source, Result](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MaxSelLsMust is like [MaxSelLs] but panics in case of error.
+func MaxSelLsMust[Source, Result any](source iter.Seq[Source], selector func(Source) Result, less func(Result, Result) bool) Result {
+	r0, err := MaxSelLs[Source, Result](source, selector, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MinMust is like [Min] but panics in case of error.
+func MinMust[Source cmp.Ordered](source iter.Seq[Source]) Source {
+	r0, err := Min[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MinBySelMust is like [MinBySel] but panics in case of error.
+func MinBySelMust[Source any, Key cmp.Ordered](source iter.Seq[Source], selector func(Source) Key) Source {
+	r0, err := MinBySel[Source, Key](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MinBySelLsMust is like [MinBySelLs] but panics in case of error.
+func MinBySelLsMust[Source, Key any](source iter.Seq[Source], selector func(Source) Key, less func(Key, Key) bool) Source {
+	r0, err := MinBySelLs[Source, Key](source, selector, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MinLsMust is like [MinLs] but panics in case of error.
+func MinLsMust[Source any](source iter.Seq[Source], less func(Source, Source) bool) Source {
+	r0, err := MinLs[Source](source, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MinSelMust is like [MinSel] but panics in case of error.
+func MinSelMust[Source any, Result cmp.Ordered](source iter.Seq[Source], selector func(Source) Result) Result {
+	r0, err := MinSel[Source, Result](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// MinSelLsMust is like [MinSelLs] but panics in case of error.
+func MinSelLsMust[Source, Result any](source iter.Seq[Source], selector func(Source) Result, less func(Result, Result) bool) Result {
+	r0, err := MinSelLs[Source, Result](source, selector, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// NewConcurrentLookupMust is like [NewConcurrentLookup] but panics in case of error.
+func NewConcurrentLookupMust[Key, Element any](comparer EqualityComparer[Key]) *ConcurrentLookup[Key, Element] {
+	r0, err := NewConcurrentLookup[Key, Element](comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// NewEnumeratorMust is like [NewEnumerator] but panics in case of error.
+func NewEnumeratorMust[T any](seq iter.Seq[T]) *Enumerator[T] {
+	r0, err := NewEnumerator[T](seq)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// NewEnumerator2Must is like [NewEnumerator2] but panics in case of error.
+func NewEnumerator2Must[K, V any](seq2 iter.Seq2[K, V]) *Enumerator2[K, V] {
+	r0, err := NewEnumerator2[K, V](seq2)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// NewHashSetMust is like [NewHashSet] but panics in case of error.
+func NewHashSetMust[T any](equal func(T, T) bool, hash func(T) uint64) *HashSet[T] {
+	r0, err := NewHashSet[T](equal, hash)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// NewSortedLookupMust is like [NewSortedLookup] but panics in case of error.
+func NewSortedLookupMust[Key, Element any](compare func(Key, Key) int) *SortedLookup[Key, Element] {
+	r0, err := NewSortedLookup[Key, Element](compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// NewSortedSetMust is like [NewSortedSet] but panics in case of error.
+func NewSortedSetMust[T any](compare func(T, T) int) *SortedSet[T] {
+	r0, err := NewSortedSet[T](compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OfTypeMust is like [OfType] but panics in case of error.
+func OfTypeMust[Source, Result any](source iter.Seq[Source]) iter.Seq[Result] {
+	r0, err := OfType[Source, Result](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByMust is like [OrderBy] but panics in case of error.
+func OrderByMust[Source cmp.Ordered](source iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := OrderBy[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByDescMust is like [OrderByDesc] but panics in case of error.
+func OrderByDescMust[Source cmp.Ordered](source iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := OrderByDesc[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByDescLsMust is like [OrderByDescLs] but panics in case of error.
+func OrderByDescLsMust[Source any](source iter.Seq[Source], less func(Source, Source) bool) iter.Seq[Source] {
+	r0, err := OrderByDescLs[Source](source, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByFieldsMust is like [OrderByFields] but panics in case of error.
+func OrderByFieldsMust[Source any](source iter.Seq[Source], fields ...string) iter.Seq[Source] {
+	r0, err := OrderByFields[Source](source, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByKeyMust is like [OrderByKey] but panics in case of error.
+func OrderByKeyMust[Source any, Key cmp.Ordered](source iter.Seq[Source], keySelector func(Source) Key) iter.Seq[Source] {
+	r0, err := OrderByKey[Source, Key](source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByKeyDescMust is like [OrderByKeyDesc] but panics in case of error.
+func OrderByKeyDescMust[Source any, Key cmp.Ordered](source iter.Seq[Source], keySelector func(Source) Key) iter.Seq[Source] {
+	r0, err := OrderByKeyDesc[Source, Key](source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByKeyDescLsMust is like [OrderByKeyDescLs] but panics in case of error.
+func OrderByKeyDescLsMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, less func(Key, Key) bool) iter.Seq[Source] {
+	r0, err := OrderByKeyDescLs[Source, Key](source, keySelector, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByKeyLsMust is like [OrderByKeyLs] but panics in case of error.
+func OrderByKeyLsMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, less func(Key, Key) bool) iter.Seq[Source] {
+	r0, err := OrderByKeyLs[Source, Key](source, keySelector, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// OrderByLsMust is like [OrderByLs] but panics in case of error.
+func OrderByLsMust[Source any](source iter.Seq[Source], less func(Source, Source) bool) iter.Seq[Source] {
+	r0, err := OrderByLs[Source](source, less)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// PageMust is like [Page] but panics in case of error.
+func PageMust[Source any](source iter.Seq[Source], page int, size int) PageResult[Source] {
+	r0, err := Page[Source](source, page, size)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// PageNoTotalMust is like [PageNoTotal] but panics in case of error.
+func PageNoTotalMust[Source any](source iter.Seq[Source], page int, size int) PageResult[Source] {
+	r0, err := PageNoTotal[Source](source, page, size)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// PrependMust is like [Prepend] but panics in case of error.
+func PrependMust[Source any](source iter.Seq[Source], element Source) iter.Seq[Source] {
+	r0, err := Prepend[Source](source, element)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// RandomElementMust is like [RandomElement] but panics in case of error.
+func RandomElementMust[Source any](source iter.Seq[Source], rng *rand.Rand) Source {
+	r0, err := RandomElement[Source](source, rng)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// RangeMust is like [Range] but panics in case of error.
+func RangeMust(start int, count int) iter.Seq[int] {
+	r0, err := Range(start, count)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// RangeStepMust is like [RangeStep] but panics in case of error.
+func RangeStepMust[T Number](start T, stop T, step T) iter.Seq[T] {
+	r0, err := RangeStep[T](start, stop, step)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// RepeatMust is like [Repeat] but panics in case of error.
+func RepeatMust[Result any](element Result, count int) iter.Seq[Result] {
+	r0, err := Repeat[Result](element, count)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ReverseMust is like [Reverse] but panics in case of error.
+func ReverseMust[Source any](source iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := Reverse[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SampleMust is like [Sample] but panics in case of error.
+func SampleMust[Source any](source iter.Seq[Source], k int, rng *rand.Rand) iter.Seq[Source] {
+	r0, err := Sample[Source](source, k, rng)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SelectMust is like [Select] but panics in case of error.
+func SelectMust[Source, Result any](source iter.Seq[Source], selector func(Source) Result) iter.Seq[Result] {
+	r0, err := Select[Source, Result](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SelectIdxMust is like [SelectIdx] but panics in case of error.
+func SelectIdxMust[Source, Result any](source iter.Seq[Source], selector func(Source, int) Result) iter.Seq[Result] {
+	r0, err := SelectIdx[Source, Result](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SelectManyMust is like [SelectMany] but panics in case of error.
+func SelectManyMust[Source, Result any](source iter.Seq[Source], selector func(Source) iter.Seq[Result]) iter.Seq[Result] {
+	r0, err := SelectMany[Source, Result](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SelectManyCollMust is like [SelectManyColl] but panics in case of error.
+func SelectManyCollMust[Source, Collection, Result any](source iter.Seq[Source], collectionSelector func(Source) iter.Seq[Collection], resultSelector func(Source, Collection) Result) iter.Seq[Result] {
+	r0, err := SelectManyColl[Source, Collection, Result](source, collectionSelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SelectManyCollIdxMust is like [SelectManyCollIdx] but panics in case of error.
+func SelectManyCollIdxMust[Source, Collection, Result any](source iter.Seq[Source], collectionSelector func(Source, int) iter.Seq[Collection], resultSelector func(Source, Collection) Result) iter.Seq[Result] {
+	r0, err := SelectManyCollIdx[Source, Collection, Result](source, collectionSelector, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SelectManyIdxMust is like [SelectManyIdx] but panics in case of error.
+func SelectManyIdxMust[Source, Result any](source iter.Seq[Source], selector func(Source, int) iter.Seq[Result]) iter.Seq[Result] {
+	r0, err := SelectManyIdx[Source, Result](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SeqStringMust is like [SeqString] but panics in case of error.
+func SeqStringMust[T any](seq iter.Seq[T]) iter.Seq[string] {
+	r0, err := SeqString[T](seq)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SequenceCompareMust is like [SequenceCompare] but panics in case of error.
+func SequenceCompareMust[Source any](first iter.Seq[Source], second iter.Seq[Source], compare func(Source, Source) int) int {
+	r0, err := SequenceCompare[Source](first, second, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SequenceCompare2Must is like [SequenceCompare2] but panics in case of error.
+func SequenceCompare2Must[K, V any](first iter.Seq2[K, V], second iter.Seq2[K, V], compareK func(K, K) int, compareV func(V, V) int) int {
+	r0, err := SequenceCompare2[K, V](first, second, compareK, compareV)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SequenceEqualMust is like [SequenceEqual] but panics in case of error.
+func SequenceEqualMust[Source any](first iter.Seq[Source], second iter.Seq[Source]) bool {
+	r0, err := SequenceEqual[Source](first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SequenceEqual2Must is like [SequenceEqual2] but panics in case of error.
+func SequenceEqual2Must[K, V any](first iter.Seq2[K, V], second iter.Seq2[K, V]) bool {
+	r0, err := SequenceEqual2[K, V](first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SequenceEqual2EqMust is like [SequenceEqual2Eq] but panics in case of error.
+func SequenceEqual2EqMust[K, V any](first iter.Seq2[K, V], second iter.Seq2[K, V], equalK func(K, K) bool, equalV func(V, V) bool) bool {
+	r0, err := SequenceEqual2Eq[K, V](first, second, equalK, equalV)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SequenceEqualEqMust is like [SequenceEqualEq] but panics in case of error.
+func SequenceEqualEqMust[Source any](first iter.Seq[Source], second iter.Seq[Source], equal func(Source, Source) bool) bool {
+	r0, err := SequenceEqualEq[Source](first, second, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SequenceMismatchMust is like [SequenceMismatch] but panics in case of error.
+func SequenceMismatchMust[Source any](first iter.Seq[Source], second iter.Seq[Source]) (Mismatch[Source], bool) {
+	r0, r1, err := SequenceMismatch[Source](first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r0, r1
+}
+
+// SequenceMismatch2Must is like [SequenceMismatch2] but panics in case of error.
+func SequenceMismatch2Must[K, V any](first iter.Seq2[K, V], second iter.Seq2[K, V]) (Mismatch2[K, V], bool) {
+	r0, r1, err := SequenceMismatch2[K, V](first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r0, r1
+}
+
+// SequenceMismatch2EqMust is like [SequenceMismatch2Eq] but panics in case of error.
+func SequenceMismatch2EqMust[K, V any](first iter.Seq2[K, V], second iter.Seq2[K, V], equalK func(K, K) bool, equalV func(V, V) bool) (Mismatch2[K, V], bool) {
+	r0, r1, err := SequenceMismatch2Eq[K, V](first, second, equalK, equalV)
+	if err != nil {
+		panic(err)
+	}
+	return r0, r1
+}
+
+// SequenceMismatchEqMust is like [SequenceMismatchEq] but panics in case of error.
+func SequenceMismatchEqMust[Source any](first iter.Seq[Source], second iter.Seq[Source], equal func(Source, Source) bool) (Mismatch[Source], bool) {
+	r0, r1, err := SequenceMismatchEq[Source](first, second, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0, r1
+}
+
+// ShuffleMust is like [Shuffle] but panics in case of error.
+func ShuffleMust[Source any](source iter.Seq[Source], rng *rand.Rand) iter.Seq[Source] {
+	r0, err := Shuffle[Source](source, rng)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SingleMust is like [Single] but panics in case of error.
+func SingleMust[Source any](source iter.Seq[Source]) Source {
+	r0, err := Single[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SingleOrDefaultMust is like [SingleOrDefault] but panics in case of error.
+func SingleOrDefaultMust[Source any](source iter.Seq[Source]) Source {
+	r0, err := SingleOrDefault[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SingleOrDefaultPredMust is like [SingleOrDefaultPred] but panics in case of error.
+func SingleOrDefaultPredMust[Source any](source iter.Seq[Source], predicate func(Source) bool) Source {
+	r0, err := SingleOrDefaultPred[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SinglePredMust is like [SinglePred] but panics in case of error.
+func SinglePredMust[Source any](source iter.Seq[Source], predicate func(Source) bool) Source {
+	r0, err := SinglePred[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SkipMust is like [Skip] but panics in case of error.
+func SkipMust[Source any](source iter.Seq[Source], count int) iter.Seq[Source] {
+	r0, err := Skip[Source](source, count)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SkipLastMust is like [SkipLast] but panics in case of error.
+func SkipLastMust[Source any](source iter.Seq[Source], count int) iter.Seq[Source] {
+	r0, err := SkipLast[Source](source, count)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SkipWhileMust is like [SkipWhile] but panics in case of error.
+func SkipWhileMust[Source any](source iter.Seq[Source], predicate func(Source) bool) iter.Seq[Source] {
+	r0, err := SkipWhile[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SkipWhileIdxMust is like [SkipWhileIdx] but panics in case of error.
+func SkipWhileIdxMust[Source any](source iter.Seq[Source], predicate func(Source, int) bool) iter.Seq[Source] {
+	r0, err := SkipWhileIdx[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SplitMust is like [Split] but panics in case of error.
+func SplitMust(r io.Reader, split bufio.SplitFunc) iter.Seq2[string, error] {
+	r0, err := Split(r, split)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// StringsMust is like [Strings] but panics in case of error.
+func StringsMust[T any](seq iter.Seq[T]) []string {
+	r0, err := Strings[T](seq)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SumMust is like [Sum] but panics in case of error.
+func SumMust[Source constraints.Integer | constraints.Float](source iter.Seq[Source]) Source {
+	r0, err := Sum[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// SumSelMust is like [SumSel] but panics in case of error.
+func SumSelMust[Source any, Result constraints.Integer | constraints.Float](source iter.Seq[Source], selector func(Source) Result) Result {
+	r0, err := SumSel[Source, Result](source, selector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// TakeMust is like [Take] but panics in case of error.
+func TakeMust[Source any](source iter.Seq[Source], count int) iter.Seq[Source] {
+	r0, err := Take[Source](source, count)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// TakeLastMust is like [TakeLast] but panics in case of error.
+func TakeLastMust[Source any](source iter.Seq[Source], count int) iter.Seq[Source] {
+	r0, err := TakeLast[Source](source, count)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// TakeWhileMust is like [TakeWhile] but panics in case of error.
+func TakeWhileMust[Source any](source iter.Seq[Source], predicate func(Source) bool) iter.Seq[Source] {
+	r0, err := TakeWhile[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// TakeWhileIdxMust is like [TakeWhileIdx] but panics in case of error.
+func TakeWhileIdxMust[Source any](source iter.Seq[Source], predicate func(Source, int) bool) iter.Seq[Source] {
+	r0, err := TakeWhileIdx[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// TapMust is like [Tap] but panics in case of error.
+func TapMust[Source any](source iter.Seq[Source], action func(Source)) iter.Seq[Source] {
+	r0, err := Tap[Source](source, action)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToHashSetMust is like [ToHashSet] but panics in case of error.
+func ToHashSetMust[Source any](source iter.Seq[Source], equal func(Source, Source) bool, hash func(Source) uint64) *HashSet[Source] {
+	r0, err := ToHashSet[Source](source, equal, hash)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToListMust is like [ToList] but panics in case of error.
+func ToListMust[Source any](source iter.Seq[Source]) *List[Source] {
+	r0, err := ToList[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToLookupMust is like [ToLookup] but panics in case of error.
+func ToLookupMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key) *Lookup[Key, Source] {
+	r0, err := ToLookup[Source, Key](source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToLookupComparerMust is like [ToLookupComparer] but panics in case of error.
+func ToLookupComparerMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, comparer EqualityComparer[Key]) *Lookup[Key, Source] {
+	r0, err := ToLookupComparer[Source, Key](source, keySelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToLookupEqMust is like [ToLookupEq] but panics in case of error.
+func ToLookupEqMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, equal func(Key, Key) bool) *Lookup[Key, Source] {
+	r0, err := ToLookupEq[Source, Key](source, keySelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToLookupSelMust is like [ToLookupSel] but panics in case of error.
+func ToLookupSelMust[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element) *Lookup[Key, Element] {
+	r0, err := ToLookupSel[Source, Key, Element](source, keySelector, elementSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToLookupSelComparerMust is like [ToLookupSelComparer] but panics in case of error.
+func ToLookupSelComparerMust[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element, comparer EqualityComparer[Key]) *Lookup[Key, Element] {
+	r0, err := ToLookupSelComparer[Source, Key, Element](source, keySelector, elementSelector, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToLookupSelEqMust is like [ToLookupSelEq] but panics in case of error.
+func ToLookupSelEqMust[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element, equal func(Key, Key) bool) *Lookup[Key, Element] {
+	r0, err := ToLookupSelEq[Source, Key, Element](source, keySelector, elementSelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToMapMust is like [ToMap] but panics in case of error.
+func ToMapMust[Source any, Key comparable](source iter.Seq[Source], keySelector func(Source) Key) map[Key]Source {
+	r0, err := ToMap[Source, Key](source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToMapCollectMust is like [ToMapCollect] but panics in case of error.
+func ToMapCollectMust[Source any, Key comparable, Value any](source iter.Seq[Source], keySelector func(Source) Key, valueSelector func(Source) Value) map[Key][]Value {
+	r0, err := ToMapCollect[Source, Key, Value](source, keySelector, valueSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToMapMergeMust is like [ToMapMerge] but panics in case of error.
+func ToMapMergeMust[Source any, Key comparable, Value any](source iter.Seq[Source], keySelector func(Source) Key, valueSelector func(Source) Value, merge func(old, new Value) Value) map[Key]Value {
+	r0, err := ToMapMerge[Source, Key, Value](source, keySelector, valueSelector, merge)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToMapSelMust is like [ToMapSel] but panics in case of error.
+func ToMapSelMust[Source any, Key comparable, Element any](source iter.Seq[Source], keySelector func(Source) Key, elementSelector func(Source) Element) map[Key]Element {
+	r0, err := ToMapSel[Source, Key, Element](source, keySelector, elementSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToSliceMust is like [ToSlice] but panics in case of error.
+func ToSliceMust[Source any](source iter.Seq[Source]) []Source {
+	r0, err := ToSlice[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToSortedLookupMust is like [ToSortedLookup] but panics in case of error.
+func ToSortedLookupMust[Source any, Key cmp.Ordered](source iter.Seq[Source], keySelector func(Source) Key) *SortedLookup[Key, Source] {
+	r0, err := ToSortedLookup[Source, Key](source, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToSortedLookupCmpMust is like [ToSortedLookupCmp] but panics in case of error.
+func ToSortedLookupCmpMust[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key, compare func(Key, Key) int) *SortedLookup[Key, Source] {
+	r0, err := ToSortedLookupCmp[Source, Key](source, keySelector, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToSortedSetMust is like [ToSortedSet] but panics in case of error.
+func ToSortedSetMust[Source cmp.Ordered](source iter.Seq[Source]) *SortedSet[Source] {
+	r0, err := ToSortedSet[Source](source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ToSortedSetCmpMust is like [ToSortedSetCmp] but panics in case of error.
+func ToSortedSetCmpMust[Source any](source iter.Seq[Source], compare func(Source, Source) int) *SortedSet[Source] {
+	r0, err := ToSortedSetCmp[Source](source, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// TraceMust is like [Trace] but panics in case of error.
+func TraceMust[Source any](source iter.Seq[Source], name string, stats *Stats) iter.Seq[Source] {
+	r0, err := Trace[Source](source, name, stats)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// UnionMust is like [Union] but panics in case of error.
+func UnionMust[Source any](first iter.Seq[Source], second iter.Seq[Source]) iter.Seq[Source] {
+	r0, err := Union[Source](first, second)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// UnionByMust is like [UnionBy] but panics in case of error.
+func UnionByMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Source], keySelector func(Source) Key) iter.Seq[Source] {
+	r0, err := UnionBy[Source, Key](first, second, keySelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// UnionByCmpMust is like [UnionByCmp] but panics in case of error.
+func UnionByCmpMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Source], keySelector func(Source) Key, compare func(Key, Key) int) iter.Seq[Source] {
+	r0, err := UnionByCmp[Source, Key](first, second, keySelector, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// UnionByEqMust is like [UnionByEq] but panics in case of error.
+func UnionByEqMust[Source, Key any](first iter.Seq[Source], second iter.Seq[Source], keySelector func(Source) Key, equal func(Key, Key) bool) iter.Seq[Source] {
+	r0, err := UnionByEq[Source, Key](first, second, keySelector, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// UnionCmpMust is like [UnionCmp] but panics in case of error.
+func UnionCmpMust[Source any](first iter.Seq[Source], second iter.Seq[Source], compare func(Source, Source) int) iter.Seq[Source] {
+	r0, err := UnionCmp[Source](first, second, compare)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// UnionComparerMust is like [UnionComparer] but panics in case of error.
+func UnionComparerMust[Source any](first iter.Seq[Source], second iter.Seq[Source], comparer EqualityComparer[Source]) iter.Seq[Source] {
+	r0, err := UnionComparer[Source](first, second, comparer)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// UnionEqMust is like [UnionEq] but panics in case of error.
+func UnionEqMust[Source any](first iter.Seq[Source], second iter.Seq[Source], equal func(Source, Source) bool) iter.Seq[Source] {
+	r0, err := UnionEq[Source](first, second, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// UnmarshalLookupJSONMust is like [UnmarshalLookupJSON] but panics in case of error.
+func UnmarshalLookupJSONMust[Key, Element any](data []byte, equal func(Key, Key) bool) *Lookup[Key, Element] {
+	r0, err := UnmarshalLookupJSON[Key, Element](data, equal)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// WeightedSampleMust is like [WeightedSample] but panics in case of error.
+func WeightedSampleMust[Source any](source iter.Seq[Source], k int, weightSelector func(Source) float64, rng *rand.Rand) iter.Seq[Source] {
+	r0, err := WeightedSample[Source](source, k, weightSelector, rng)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// WhereMust is like [Where] but panics in case of error.
+func WhereMust[Source any](source iter.Seq[Source], predicate func(Source) bool) iter.Seq[Source] {
+	r0, err := Where[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// WhereIdxMust is like [WhereIdx] but panics in case of error.
+func WhereIdxMust[Source any](source iter.Seq[Source], predicate func(Source, int) bool) iter.Seq[Source] {
+	r0, err := WhereIdx[Source](source, predicate)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// WriteCSVMust is like [WriteCSV] but panics in case of error.
+func WriteCSVMust[Source any](w io.Writer, source iter.Seq[Source], rowFunc func(Source) []string) int {
+	r0, err := WriteCSV[Source](w, source, rowFunc)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// WriteJSONArrayMust is like [WriteJSONArray] but panics in case of error.
+func WriteJSONArrayMust[Source any](w io.Writer, source iter.Seq[Source]) int {
+	r0, err := WriteJSONArray[Source](w, source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// WriteJSONLinesMust is like [WriteJSONLines] but panics in case of error.
+func WriteJSONLinesMust[Source any](w io.Writer, source iter.Seq[Source]) int {
+	r0, err := WriteJSONLines[Source](w, source)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// WriteLinesMust is like [WriteLines] but panics in case of error.
+func WriteLinesMust[Source any](w io.Writer, source iter.Seq[Source], format string) int {
+	r0, err := WriteLines[Source](w, source, format)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
+
+// ZipMust is like [Zip] but panics in case of error.
+func ZipMust[First, Second, Result any](first iter.Seq[First], second iter.Seq[Second], resultSelector func(First, Second) Result) iter.Seq[Result] {
+	r0, err := Zip[First, Second, Result](first, second, resultSelector)
+	if err != nil {
+		panic(err)
+	}
+	return r0
+}
@@ -0,0 +1,814 @@
+// Code generated by mustgen. DO NOT EDIT.
+
+package go2linq
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestMust verifies that each Must function called with nil, zero or negative arguments
+// panics with the error returned by the corresponding function and does not panic otherwise.
+func TestMust(t *testing.T) {
+	tests := []struct {
+		name string
+		call func() error
+		must func()
+	}{
+		{name: "Aggregate",
+			call: func() error { _, err := Aggregate[int](nil, nil); return err },
+			must: func() { _ = AggregateMust[int](nil, nil) },
+		},
+		{name: "AggregateSeed",
+			call: func() error { _, err := AggregateSeed[int, int](nil, -1, nil); return err },
+			must: func() { _ = AggregateSeedMust[int, int](nil, -1, nil) },
+		},
+		{name: "AggregateSeedSel",
+			call: func() error { _, err := AggregateSeedSel[int, int, int](nil, -1, nil, nil); return err },
+			must: func() { _ = AggregateSeedSelMust[int, int, int](nil, -1, nil, nil) },
+		},
+		{name: "All",
+			call: func() error { _, err := All[int](nil, nil); return err },
+			must: func() { _ = AllMust[int](nil, nil) },
+		},
+		{name: "Any",
+			call: func() error { _, err := Any[int](nil); return err },
+			must: func() { _ = AnyMust[int](nil) },
+		},
+		{name: "AnyPred",
+			call: func() error { _, err := AnyPred[int](nil, nil); return err },
+			must: func() { _ = AnyPredMust[int](nil, nil) },
+		},
+		{name: "Append",
+			call: func() error { _, err := Append[int](nil, -1); return err },
+			must: func() { _ = AppendMust[int](nil, -1) },
+		},
+		{name: "ApplyResultSelector",
+			call: func() error { _, err := ApplyResultSelector[int, int, int](nil, nil); return err },
+			must: func() { _ = ApplyResultSelectorMust[int, int, int](nil, nil) },
+		},
+		{name: "Average",
+			call: func() error { _, err := Average[int](nil); return err },
+			must: func() { _ = AverageMust[int](nil) },
+		},
+		{name: "AverageSel",
+			call: func() error { _, err := AverageSel[int, int](nil, nil); return err },
+			must: func() { _ = AverageSelMust[int, int](nil, nil) },
+		},
+		{name: "CSVRecords",
+			call: func() error { _, err := CSVRecords(*new(io.Reader), nil); return err },
+			must: func() { _ = CSVRecordsMust(*new(io.Reader), nil) },
+		},
+		{name: "Cast",
+			call: func() error { _, err := Cast[int, int](nil); return err },
+			must: func() { _ = CastMust[int, int](nil) },
+		},
+		{name: "CastErr",
+			call: func() error { _, err := CastErr[int, int](nil); return err },
+			must: func() { _ = CastErrMust[int, int](nil) },
+		},
+		{name: "Chunk",
+			call: func() error { _, err := Chunk[int](nil, -1); return err },
+			must: func() { _ = ChunkMust[int](nil, -1) },
+		},
+		{name: "ChunkTimeout",
+			call: func() error {
+				_, err := ChunkTimeout[int](*new(context.Context), nil, -1, *new(time.Duration))
+				return err
+			},
+			must: func() { _ = ChunkTimeoutMust[int](*new(context.Context), nil, -1, *new(time.Duration)) },
+		},
+		{name: "ChunkTimeoutClock",
+			call: func() error {
+				_, err := ChunkTimeoutClock[int](*new(context.Context), nil, -1, *new(time.Duration), *new(Clock))
+				return err
+			},
+			must: func() {
+				_ = ChunkTimeoutClockMust[int](*new(context.Context), nil, -1, *new(time.Duration), *new(Clock))
+			},
+		},
+		{name: "Concat",
+			call: func() error { _, err := Concat[int](nil, nil); return err },
+			must: func() { _ = ConcatMust[int](nil, nil) },
+		},
+		{name: "Contains",
+			call: func() error { _, err := Contains[int](nil, -1); return err },
+			must: func() { _ = ContainsMust[int](nil, -1) },
+		},
+		{name: "ContainsEq",
+			call: func() error { _, err := ContainsEq[int](nil, -1, nil); return err },
+			must: func() { _ = ContainsEqMust[int](nil, -1, nil) },
+		},
+		{name: "Convert",
+			call: func() error { _, err := Convert[int, int](nil); return err },
+			must: func() { _ = ConvertMust[int, int](nil) },
+		},
+		{name: "Count",
+			call: func() error { _, err := Count[int](nil); return err },
+			must: func() { _ = CountMust[int](nil) },
+		},
+		{name: "CountPred",
+			call: func() error { _, err := CountPred[int](nil, nil); return err },
+			must: func() { _ = CountPredMust[int](nil, nil) },
+		},
+		{name: "Cycle",
+			call: func() error { _, err := Cycle[int](nil); return err },
+			must: func() { _ = CycleMust[int](nil) },
+		},
+		{name: "DefaultIfEmpty",
+			call: func() error { _, err := DefaultIfEmpty[int](nil); return err },
+			must: func() { _ = DefaultIfEmptyMust[int](nil) },
+		},
+		{name: "DefaultIfEmptyDef",
+			call: func() error { _, err := DefaultIfEmptyDef[int](nil, -1); return err },
+			must: func() { _ = DefaultIfEmptyDefMust[int](nil, -1) },
+		},
+		{name: "Diff",
+			call: func() error { _, err := Diff[int](nil, nil, nil); return err },
+			must: func() { _ = DiffMust[int](nil, nil, nil) },
+		},
+		{name: "DiffBy",
+			call: func() error { _, err := DiffBy[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = DiffByMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "Distinct",
+			call: func() error { _, err := Distinct[int](nil); return err },
+			must: func() { _ = DistinctMust[int](nil) },
+		},
+		{name: "DistinctBy",
+			call: func() error { _, err := DistinctBy[int, int](nil, nil); return err },
+			must: func() { _ = DistinctByMust[int, int](nil, nil) },
+		},
+		{name: "DistinctByCmp",
+			call: func() error { _, err := DistinctByCmp[int, int](nil, nil, nil); return err },
+			must: func() { _ = DistinctByCmpMust[int, int](nil, nil, nil) },
+		},
+		{name: "DistinctByEq",
+			call: func() error { _, err := DistinctByEq[int, int](nil, nil, nil); return err },
+			must: func() { _ = DistinctByEqMust[int, int](nil, nil, nil) },
+		},
+		{name: "DistinctCmp",
+			call: func() error { _, err := DistinctCmp[int](nil, nil); return err },
+			must: func() { _ = DistinctCmpMust[int](nil, nil) },
+		},
+		{name: "DistinctComparer",
+			call: func() error { _, err := DistinctComparer[int](nil, *new(EqualityComparer[int])); return err },
+			must: func() { _ = DistinctComparerMust[int](nil, *new(EqualityComparer[int])) },
+		},
+		{name: "DistinctEq",
+			call: func() error { _, err := DistinctEq[int](nil, nil); return err },
+			must: func() { _ = DistinctEqMust[int](nil, nil) },
+		},
+		{name: "ElementAt",
+			call: func() error { _, err := ElementAt[int](nil, -1); return err },
+			must: func() { _ = ElementAtMust[int](nil, -1) },
+		},
+		{name: "ElementAtOrDefault",
+			call: func() error { _, err := ElementAtOrDefault[int](nil, -1); return err },
+			must: func() { _ = ElementAtOrDefaultMust[int](nil, -1) },
+		},
+		{name: "Except",
+			call: func() error { _, err := Except[int](nil, nil); return err },
+			must: func() { _ = ExceptMust[int](nil, nil) },
+		},
+		{name: "ExceptBy",
+			call: func() error { _, err := ExceptBy[int, int](nil, nil, nil); return err },
+			must: func() { _ = ExceptByMust[int, int](nil, nil, nil) },
+		},
+		{name: "ExceptByCmp",
+			call: func() error { _, err := ExceptByCmp[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = ExceptByCmpMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "ExceptByEq",
+			call: func() error { _, err := ExceptByEq[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = ExceptByEqMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "ExceptCmp",
+			call: func() error { _, err := ExceptCmp[int](nil, nil, nil); return err },
+			must: func() { _ = ExceptCmpMust[int](nil, nil, nil) },
+		},
+		{name: "ExceptEq",
+			call: func() error { _, err := ExceptEq[int](nil, nil, nil); return err },
+			must: func() { _ = ExceptEqMust[int](nil, nil, nil) },
+		},
+		{name: "FieldSelector",
+			call: func() error { _, err := FieldSelector[int](""); return err },
+			must: func() { _ = FieldSelectorMust[int]("") },
+		},
+		{name: "First",
+			call: func() error { _, err := First[int](nil); return err },
+			must: func() { _ = FirstMust[int](nil) },
+		},
+		{name: "FirstOrDefault",
+			call: func() error { _, err := FirstOrDefault[int](nil); return err },
+			must: func() { _ = FirstOrDefaultMust[int](nil) },
+		},
+		{name: "FirstOrDefaultPred",
+			call: func() error { _, err := FirstOrDefaultPred[int](nil, nil); return err },
+			must: func() { _ = FirstOrDefaultPredMust[int](nil, nil) },
+		},
+		{name: "FirstPred",
+			call: func() error { _, err := FirstPred[int](nil, nil); return err },
+			must: func() { _ = FirstPredMust[int](nil, nil) },
+		},
+		{name: "ForEach",
+			call: func() error { return ForEach[int](*new(context.Context), nil, nil) },
+			must: func() { ForEachMust[int](*new(context.Context), nil, nil) },
+		},
+		{name: "ForEachConcurrent",
+			call: func() error { return ForEachConcurrent[int](*new(context.Context), nil, nil) },
+			must: func() { ForEachConcurrentMust[int](*new(context.Context), nil, nil) },
+		},
+		{name: "Generate",
+			call: func() error { _, err := Generate[int](-1, nil); return err },
+			must: func() { _ = GenerateMust[int](-1, nil) },
+		},
+		{name: "GroupBy",
+			call: func() error { _, err := GroupBy[int, int](nil, nil); return err },
+			must: func() { _ = GroupByMust[int, int](nil, nil) },
+		},
+		{name: "GroupByComparer",
+			call: func() error { _, err := GroupByComparer[int, int](nil, nil, *new(EqualityComparer[int])); return err },
+			must: func() { _ = GroupByComparerMust[int, int](nil, nil, *new(EqualityComparer[int])) },
+		},
+		{name: "GroupByEq",
+			call: func() error { _, err := GroupByEq[int, int](nil, nil, nil); return err },
+			must: func() { _ = GroupByEqMust[int, int](nil, nil, nil) },
+		},
+		{name: "GroupByField",
+			call: func() error { _, err := GroupByField[int](nil, ""); return err },
+			must: func() { _ = GroupByFieldMust[int](nil, "") },
+		},
+		{name: "GroupByRes",
+			call: func() error { _, err := GroupByRes[int, int, int](nil, nil, nil); return err },
+			must: func() { _ = GroupByResMust[int, int, int](nil, nil, nil) },
+		},
+		{name: "GroupByResEq",
+			call: func() error { _, err := GroupByResEq[int, int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = GroupByResEqMust[int, int, int](nil, nil, nil, nil) },
+		},
+		{name: "GroupBySel",
+			call: func() error { _, err := GroupBySel[int, int, int](nil, nil, nil); return err },
+			must: func() { _ = GroupBySelMust[int, int, int](nil, nil, nil) },
+		},
+		{name: "GroupBySelComparer",
+			call: func() error {
+				_, err := GroupBySelComparer[int, int, int](nil, nil, nil, *new(EqualityComparer[int]))
+				return err
+			},
+			must: func() { _ = GroupBySelComparerMust[int, int, int](nil, nil, nil, *new(EqualityComparer[int])) },
+		},
+		{name: "GroupBySelEq",
+			call: func() error { _, err := GroupBySelEq[int, int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = GroupBySelEqMust[int, int, int](nil, nil, nil, nil) },
+		},
+		{name: "GroupBySelRes",
+			call: func() error { _, err := GroupBySelRes[int, int, int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = GroupBySelResMust[int, int, int, int](nil, nil, nil, nil) },
+		},
+		{name: "GroupBySelResEq",
+			call: func() error { _, err := GroupBySelResEq[int, int, int, int](nil, nil, nil, nil, nil); return err },
+			must: func() { _ = GroupBySelResEqMust[int, int, int, int](nil, nil, nil, nil, nil) },
+		},
+		{name: "GroupJoin",
+			call: func() error { _, err := GroupJoin[int, int, int, int](nil, nil, nil, nil, nil); return err },
+			must: func() { _ = GroupJoinMust[int, int, int, int](nil, nil, nil, nil, nil) },
+		},
+		{name: "GroupJoinEq",
+			call: func() error { _, err := GroupJoinEq[int, int, int, int](nil, nil, nil, nil, nil, nil); return err },
+			must: func() { _ = GroupJoinEqMust[int, int, int, int](nil, nil, nil, nil, nil, nil) },
+		},
+		{name: "Intersect",
+			call: func() error { _, err := Intersect[int](nil, nil); return err },
+			must: func() { _ = IntersectMust[int](nil, nil) },
+		},
+		{name: "IntersectBy",
+			call: func() error { _, err := IntersectBy[int, int](nil, nil, nil); return err },
+			must: func() { _ = IntersectByMust[int, int](nil, nil, nil) },
+		},
+		{name: "IntersectByCmp",
+			call: func() error { _, err := IntersectByCmp[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = IntersectByCmpMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "IntersectByEq",
+			call: func() error { _, err := IntersectByEq[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = IntersectByEqMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "IntersectCmp",
+			call: func() error { _, err := IntersectCmp[int](nil, nil, nil); return err },
+			must: func() { _ = IntersectCmpMust[int](nil, nil, nil) },
+		},
+		{name: "IntersectEq",
+			call: func() error { _, err := IntersectEq[int](nil, nil, nil); return err },
+			must: func() { _ = IntersectEqMust[int](nil, nil, nil) },
+		},
+		{name: "Iterate",
+			call: func() error { _, err := Iterate[int](-1, nil); return err },
+			must: func() { _ = IterateMust[int](-1, nil) },
+		},
+		{name: "JSONArray",
+			call: func() error { _, err := JSONArray[int](*new(io.Reader)); return err },
+			must: func() { _ = JSONArrayMust[int](*new(io.Reader)) },
+		},
+		{name: "JSONStream",
+			call: func() error { _, err := JSONStream[int](*new(io.Reader)); return err },
+			must: func() { _ = JSONStreamMust[int](*new(io.Reader)) },
+		},
+		{name: "JSONValues",
+			call: func() error { _, err := JSONValues[int](*new(io.Reader)); return err },
+			must: func() { _ = JSONValuesMust[int](*new(io.Reader)) },
+		},
+		{name: "Join",
+			call: func() error { _, err := Join[int, int, int, int](nil, nil, nil, nil, nil); return err },
+			must: func() { _ = JoinMust[int, int, int, int](nil, nil, nil, nil, nil) },
+		},
+		{name: "JoinComparer",
+			call: func() error {
+				_, err := JoinComparer[int, int, int, int](nil, nil, nil, nil, nil, *new(EqualityComparer[int]))
+				return err
+			},
+			must: func() { _ = JoinComparerMust[int, int, int, int](nil, nil, nil, nil, nil, *new(EqualityComparer[int])) },
+		},
+		{name: "JoinEq",
+			call: func() error { _, err := JoinEq[int, int, int, int](nil, nil, nil, nil, nil, nil); return err },
+			must: func() { _ = JoinEqMust[int, int, int, int](nil, nil, nil, nil, nil, nil) },
+		},
+		{name: "KeysetPage",
+			call: func() error { _, err := KeysetPage[int, int](nil, nil, nil, -1, nil); return err },
+			must: func() { _ = KeysetPageMust[int, int](nil, nil, nil, -1, nil) },
+		},
+		{name: "Last",
+			call: func() error { _, err := Last[int](nil); return err },
+			must: func() { _ = LastMust[int](nil) },
+		},
+		{name: "LastOrDefault",
+			call: func() error { _, err := LastOrDefault[int](nil); return err },
+			must: func() { _ = LastOrDefaultMust[int](nil) },
+		},
+		{name: "LastOrDefaultPred",
+			call: func() error { _, err := LastOrDefaultPred[int](nil, nil); return err },
+			must: func() { _ = LastOrDefaultPredMust[int](nil, nil) },
+		},
+		{name: "LastPred",
+			call: func() error { _, err := LastPred[int](nil, nil); return err },
+			must: func() { _ = LastPredMust[int](nil, nil) },
+		},
+		{name: "Lines",
+			call: func() error { _, err := Lines(*new(io.Reader)); return err },
+			must: func() { _ = LinesMust(*new(io.Reader)) },
+		},
+		{name: "LookupToMap",
+			call: func() error { _, err := LookupToMap[int, int](nil); return err },
+			must: func() { _ = LookupToMapMust[int, int](nil) },
+		},
+		{name: "Max",
+			call: func() error { _, err := Max[int](nil); return err },
+			must: func() { _ = MaxMust[int](nil) },
+		},
+		{name: "MaxBySel",
+			call: func() error { _, err := MaxBySel[int, int](nil, nil); return err },
+			must: func() { _ = MaxBySelMust[int, int](nil, nil) },
+		},
+		{name: "MaxBySelLs",
+			call: func() error { _, err := MaxBySelLs[int, int](nil, nil, nil); return err },
+			must: func() { _ = MaxBySelLsMust[int, int](nil, nil, nil) },
+		},
+		{name: "MaxLs",
+			call: func() error { _, err := MaxLs[int](nil, nil); return err },
+			must: func() { _ = MaxLsMust[int](nil, nil) },
+		},
+		{name: "MaxSel",
+			call: func() error { _, err := MaxSel[int, int](nil, nil); return err },
+			must: func() { _ = MaxSelMust[int, int](nil, nil) },
+		},
+		{name: "MaxSelLs",
+			call: func() error { _, err := MaxSelLs[int, int](nil, nil, nil); return err },
+			must: func() { _ = MaxSelLsMust[int, int](nil, nil, nil) },
+		},
+		{name: "Min",
+			call: func() error { _, err := Min[int](nil); return err },
+			must: func() { _ = MinMust[int](nil) },
+		},
+		{name: "MinBySel",
+			call: func() error { _, err := MinBySel[int, int](nil, nil); return err },
+			must: func() { _ = MinBySelMust[int, int](nil, nil) },
+		},
+		{name: "MinBySelLs",
+			call: func() error { _, err := MinBySelLs[int, int](nil, nil, nil); return err },
+			must: func() { _ = MinBySelLsMust[int, int](nil, nil, nil) },
+		},
+		{name: "MinLs",
+			call: func() error { _, err := MinLs[int](nil, nil); return err },
+			must: func() { _ = MinLsMust[int](nil, nil) },
+		},
+		{name: "MinSel",
+			call: func() error { _, err := MinSel[int, int](nil, nil); return err },
+			must: func() { _ = MinSelMust[int, int](nil, nil) },
+		},
+		{name: "MinSelLs",
+			call: func() error { _, err := MinSelLs[int, int](nil, nil, nil); return err },
+			must: func() { _ = MinSelLsMust[int, int](nil, nil, nil) },
+		},
+		{name: "NewConcurrentLookup",
+			call: func() error { _, err := NewConcurrentLookup[int, int](*new(EqualityComparer[int])); return err },
+			must: func() { _ = NewConcurrentLookupMust[int, int](*new(EqualityComparer[int])) },
+		},
+		{name: "NewEnumerator",
+			call: func() error { _, err := NewEnumerator[int](nil); return err },
+			must: func() { _ = NewEnumeratorMust[int](nil) },
+		},
+		{name: "NewEnumerator2",
+			call: func() error { _, err := NewEnumerator2[int, int](nil); return err },
+			must: func() { _ = NewEnumerator2Must[int, int](nil) },
+		},
+		{name: "NewHashSet",
+			call: func() error { _, err := NewHashSet[int](nil, nil); return err },
+			must: func() { _ = NewHashSetMust[int](nil, nil) },
+		},
+		{name: "NewSortedLookup",
+			call: func() error { _, err := NewSortedLookup[int, int](nil); return err },
+			must: func() { _ = NewSortedLookupMust[int, int](nil) },
+		},
+		{name: "NewSortedSet",
+			call: func() error { _, err := NewSortedSet[int](nil); return err },
+			must: func() { _ = NewSortedSetMust[int](nil) },
+		},
+		{name: "OfType",
+			call: func() error { _, err := OfType[int, int](nil); return err },
+			must: func() { _ = OfTypeMust[int, int](nil) },
+		},
+		{name: "OrderBy",
+			call: func() error { _, err := OrderBy[int](nil); return err },
+			must: func() { _ = OrderByMust[int](nil) },
+		},
+		{name: "OrderByDesc",
+			call: func() error { _, err := OrderByDesc[int](nil); return err },
+			must: func() { _ = OrderByDescMust[int](nil) },
+		},
+		{name: "OrderByDescLs",
+			call: func() error { _, err := OrderByDescLs[int](nil, nil); return err },
+			must: func() { _ = OrderByDescLsMust[int](nil, nil) },
+		},
+		{name: "OrderByFields",
+			call: func() error { _, err := OrderByFields[int](nil); return err },
+			must: func() { _ = OrderByFieldsMust[int](nil) },
+		},
+		{name: "OrderByKey",
+			call: func() error { _, err := OrderByKey[int, int](nil, nil); return err },
+			must: func() { _ = OrderByKeyMust[int, int](nil, nil) },
+		},
+		{name: "OrderByKeyDesc",
+			call: func() error { _, err := OrderByKeyDesc[int, int](nil, nil); return err },
+			must: func() { _ = OrderByKeyDescMust[int, int](nil, nil) },
+		},
+		{name: "OrderByKeyDescLs",
+			call: func() error { _, err := OrderByKeyDescLs[int, int](nil, nil, nil); return err },
+			must: func() { _ = OrderByKeyDescLsMust[int, int](nil, nil, nil) },
+		},
+		{name: "OrderByKeyLs",
+			call: func() error { _, err := OrderByKeyLs[int, int](nil, nil, nil); return err },
+			must: func() { _ = OrderByKeyLsMust[int, int](nil, nil, nil) },
+		},
+		{name: "OrderByLs",
+			call: func() error { _, err := OrderByLs[int](nil, nil); return err },
+			must: func() { _ = OrderByLsMust[int](nil, nil) },
+		},
+		{name: "Page",
+			call: func() error { _, err := Page[int](nil, -1, -1); return err },
+			must: func() { _ = PageMust[int](nil, -1, -1) },
+		},
+		{name: "PageNoTotal",
+			call: func() error { _, err := PageNoTotal[int](nil, -1, -1); return err },
+			must: func() { _ = PageNoTotalMust[int](nil, -1, -1) },
+		},
+		{name: "Prepend",
+			call: func() error { _, err := Prepend[int](nil, -1); return err },
+			must: func() { _ = PrependMust[int](nil, -1) },
+		},
+		{name: "RandomElement",
+			call: func() error { _, err := RandomElement[int](nil, nil); return err },
+			must: func() { _ = RandomElementMust[int](nil, nil) },
+		},
+		{name: "Range",
+			call: func() error { _, err := Range(-1, -1); return err },
+			must: func() { _ = RangeMust(-1, -1) },
+		},
+		{name: "RangeStep",
+			call: func() error { _, err := RangeStep[int](-1, -1, -1); return err },
+			must: func() { _ = RangeStepMust[int](-1, -1, -1) },
+		},
+		{name: "Repeat",
+			call: func() error { _, err := Repeat[int](-1, -1); return err },
+			must: func() { _ = RepeatMust[int](-1, -1) },
+		},
+		{name: "Reverse",
+			call: func() error { _, err := Reverse[int](nil); return err },
+			must: func() { _ = ReverseMust[int](nil) },
+		},
+		{name: "Sample",
+			call: func() error { _, err := Sample[int](nil, -1, nil); return err },
+			must: func() { _ = SampleMust[int](nil, -1, nil) },
+		},
+		{name: "Select",
+			call: func() error { _, err := Select[int, int](nil, nil); return err },
+			must: func() { _ = SelectMust[int, int](nil, nil) },
+		},
+		{name: "SelectIdx",
+			call: func() error { _, err := SelectIdx[int, int](nil, nil); return err },
+			must: func() { _ = SelectIdxMust[int, int](nil, nil) },
+		},
+		{name: "SelectMany",
+			call: func() error { _, err := SelectMany[int, int](nil, nil); return err },
+			must: func() { _ = SelectManyMust[int, int](nil, nil) },
+		},
+		{name: "SelectManyColl",
+			call: func() error { _, err := SelectManyColl[int, int, int](nil, nil, nil); return err },
+			must: func() { _ = SelectManyCollMust[int, int, int](nil, nil, nil) },
+		},
+		{name: "SelectManyCollIdx",
+			call: func() error { _, err := SelectManyCollIdx[int, int, int](nil, nil, nil); return err },
+			must: func() { _ = SelectManyCollIdxMust[int, int, int](nil, nil, nil) },
+		},
+		{name: "SelectManyIdx",
+			call: func() error { _, err := SelectManyIdx[int, int](nil, nil); return err },
+			must: func() { _ = SelectManyIdxMust[int, int](nil, nil) },
+		},
+		{name: "SeqString",
+			call: func() error { _, err := SeqString[int](nil); return err },
+			must: func() { _ = SeqStringMust[int](nil) },
+		},
+		{name: "SequenceCompare",
+			call: func() error { _, err := SequenceCompare[int](nil, nil, nil); return err },
+			must: func() { _ = SequenceCompareMust[int](nil, nil, nil) },
+		},
+		{name: "SequenceCompare2",
+			call: func() error { _, err := SequenceCompare2[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = SequenceCompare2Must[int, int](nil, nil, nil, nil) },
+		},
+		{name: "SequenceEqual",
+			call: func() error { _, err := SequenceEqual[int](nil, nil); return err },
+			must: func() { _ = SequenceEqualMust[int](nil, nil) },
+		},
+		{name: "SequenceEqual2",
+			call: func() error { _, err := SequenceEqual2[int, int](nil, nil); return err },
+			must: func() { _ = SequenceEqual2Must[int, int](nil, nil) },
+		},
+		{name: "SequenceEqual2Eq",
+			call: func() error { _, err := SequenceEqual2Eq[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = SequenceEqual2EqMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "SequenceEqualEq",
+			call: func() error { _, err := SequenceEqualEq[int](nil, nil, nil); return err },
+			must: func() { _ = SequenceEqualEqMust[int](nil, nil, nil) },
+		},
+		{name: "SequenceMismatch",
+			call: func() error { _, _, err := SequenceMismatch[int](nil, nil); return err },
+			must: func() { _, _ = SequenceMismatchMust[int](nil, nil) },
+		},
+		{name: "SequenceMismatch2",
+			call: func() error { _, _, err := SequenceMismatch2[int, int](nil, nil); return err },
+			must: func() { _, _ = SequenceMismatch2Must[int, int](nil, nil) },
+		},
+		{name: "SequenceMismatch2Eq",
+			call: func() error { _, _, err := SequenceMismatch2Eq[int, int](nil, nil, nil, nil); return err },
+			must: func() { _, _ = SequenceMismatch2EqMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "SequenceMismatchEq",
+			call: func() error { _, _, err := SequenceMismatchEq[int](nil, nil, nil); return err },
+			must: func() { _, _ = SequenceMismatchEqMust[int](nil, nil, nil) },
+		},
+		{name: "Shuffle",
+			call: func() error { _, err := Shuffle[int](nil, nil); return err },
+			must: func() { _ = ShuffleMust[int](nil, nil) },
+		},
+		{name: "Single",
+			call: func() error { _, err := Single[int](nil); return err },
+			must: func() { _ = SingleMust[int](nil) },
+		},
+		{name: "SingleOrDefault",
+			call: func() error { _, err := SingleOrDefault[int](nil); return err },
+			must: func() { _ = SingleOrDefaultMust[int](nil) },
+		},
+		{name: "SingleOrDefaultPred",
+			call: func() error { _, err := SingleOrDefaultPred[int](nil, nil); return err },
+			must: func() { _ = SingleOrDefaultPredMust[int](nil, nil) },
+		},
+		{name: "SinglePred",
+			call: func() error { _, err := SinglePred[int](nil, nil); return err },
+			must: func() { _ = SinglePredMust[int](nil, nil) },
+		},
+		{name: "Skip",
+			call: func() error { _, err := Skip[int](nil, -1); return err },
+			must: func() { _ = SkipMust[int](nil, -1) },
+		},
+		{name: "SkipLast",
+			call: func() error { _, err := SkipLast[int](nil, -1); return err },
+			must: func() { _ = SkipLastMust[int](nil, -1) },
+		},
+		{name: "SkipWhile",
+			call: func() error { _, err := SkipWhile[int](nil, nil); return err },
+			must: func() { _ = SkipWhileMust[int](nil, nil) },
+		},
+		{name: "SkipWhileIdx",
+			call: func() error { _, err := SkipWhileIdx[int](nil, nil); return err },
+			must: func() { _ = SkipWhileIdxMust[int](nil, nil) },
+		},
+		{name: "Split",
+			call: func() error { _, err := Split(*new(io.Reader), *new(bufio.SplitFunc)); return err },
+			must: func() { _ = SplitMust(*new(io.Reader), *new(bufio.SplitFunc)) },
+		},
+		{name: "Strings",
+			call: func() error { _, err := Strings[int](nil); return err },
+			must: func() { _ = StringsMust[int](nil) },
+		},
+		{name: "Sum",
+			call: func() error { _, err := Sum[int](nil); return err },
+			must: func() { _ = SumMust[int](nil) },
+		},
+		{name: "SumSel",
+			call: func() error { _, err := SumSel[int, int](nil, nil); return err },
+			must: func() { _ = SumSelMust[int, int](nil, nil) },
+		},
+		{name: "Take",
+			call: func() error { _, err := Take[int](nil, -1); return err },
+			must: func() { _ = TakeMust[int](nil, -1) },
+		},
+		{name: "TakeLast",
+			call: func() error { _, err := TakeLast[int](nil, -1); return err },
+			must: func() { _ = TakeLastMust[int](nil, -1) },
+		},
+		{name: "TakeWhile",
+			call: func() error { _, err := TakeWhile[int](nil, nil); return err },
+			must: func() { _ = TakeWhileMust[int](nil, nil) },
+		},
+		{name: "TakeWhileIdx",
+			call: func() error { _, err := TakeWhileIdx[int](nil, nil); return err },
+			must: func() { _ = TakeWhileIdxMust[int](nil, nil) },
+		},
+		{name: "Tap",
+			call: func() error { _, err := Tap[int](nil, nil); return err },
+			must: func() { _ = TapMust[int](nil, nil) },
+		},
+		{name: "ToHashSet",
+			call: func() error { _, err := ToHashSet[int](nil, nil, nil); return err },
+			must: func() { _ = ToHashSetMust[int](nil, nil, nil) },
+		},
+		{name: "ToList",
+			call: func() error { _, err := ToList[int](nil); return err },
+			must: func() { _ = ToListMust[int](nil) },
+		},
+		{name: "ToLookup",
+			call: func() error { _, err := ToLookup[int, int](nil, nil); return err },
+			must: func() { _ = ToLookupMust[int, int](nil, nil) },
+		},
+		{name: "ToLookupComparer",
+			call: func() error { _, err := ToLookupComparer[int, int](nil, nil, *new(EqualityComparer[int])); return err },
+			must: func() { _ = ToLookupComparerMust[int, int](nil, nil, *new(EqualityComparer[int])) },
+		},
+		{name: "ToLookupEq",
+			call: func() error { _, err := ToLookupEq[int, int](nil, nil, nil); return err },
+			must: func() { _ = ToLookupEqMust[int, int](nil, nil, nil) },
+		},
+		{name: "ToLookupSel",
+			call: func() error { _, err := ToLookupSel[int, int, int](nil, nil, nil); return err },
+			must: func() { _ = ToLookupSelMust[int, int, int](nil, nil, nil) },
+		},
+		{name: "ToLookupSelComparer",
+			call: func() error {
+				_, err := ToLookupSelComparer[int, int, int](nil, nil, nil, *new(EqualityComparer[int]))
+				return err
+			},
+			must: func() { _ = ToLookupSelComparerMust[int, int, int](nil, nil, nil, *new(EqualityComparer[int])) },
+		},
+		{name: "ToLookupSelEq",
+			call: func() error { _, err := ToLookupSelEq[int, int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = ToLookupSelEqMust[int, int, int](nil, nil, nil, nil) },
+		},
+		{name: "ToMap",
+			call: func() error { _, err := ToMap[int, int](nil, nil); return err },
+			must: func() { _ = ToMapMust[int, int](nil, nil) },
+		},
+		{name: "ToMapCollect",
+			call: func() error { _, err := ToMapCollect[int, int, int](nil, nil, nil); return err },
+			must: func() { _ = ToMapCollectMust[int, int, int](nil, nil, nil) },
+		},
+		{name: "ToMapMerge",
+			call: func() error { _, err := ToMapMerge[int, int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = ToMapMergeMust[int, int, int](nil, nil, nil, nil) },
+		},
+		{name: "ToMapSel",
+			call: func() error { _, err := ToMapSel[int, int, int](nil, nil, nil); return err },
+			must: func() { _ = ToMapSelMust[int, int, int](nil, nil, nil) },
+		},
+		{name: "ToSlice",
+			call: func() error { _, err := ToSlice[int](nil); return err },
+			must: func() { _ = ToSliceMust[int](nil) },
+		},
+		{name: "ToSortedLookup",
+			call: func() error { _, err := ToSortedLookup[int, int](nil, nil); return err },
+			must: func() { _ = ToSortedLookupMust[int, int](nil, nil) },
+		},
+		{name: "ToSortedLookupCmp",
+			call: func() error { _, err := ToSortedLookupCmp[int, int](nil, nil, nil); return err },
+			must: func() { _ = ToSortedLookupCmpMust[int, int](nil, nil, nil) },
+		},
+		{name: "ToSortedSet",
+			call: func() error { _, err := ToSortedSet[int](nil); return err },
+			must: func() { _ = ToSortedSetMust[int](nil) },
+		},
+		{name: "ToSortedSetCmp",
+			call: func() error { _, err := ToSortedSetCmp[int](nil, nil); return err },
+			must: func() { _ = ToSortedSetCmpMust[int](nil, nil) },
+		},
+		{name: "Trace",
+			call: func() error { _, err := Trace[int](nil, "", nil); return err },
+			must: func() { _ = TraceMust[int](nil, "", nil) },
+		},
+		{name: "Union",
+			call: func() error { _, err := Union[int](nil, nil); return err },
+			must: func() { _ = UnionMust[int](nil, nil) },
+		},
+		{name: "UnionBy",
+			call: func() error { _, err := UnionBy[int, int](nil, nil, nil); return err },
+			must: func() { _ = UnionByMust[int, int](nil, nil, nil) },
+		},
+		{name: "UnionByCmp",
+			call: func() error { _, err := UnionByCmp[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = UnionByCmpMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "UnionByEq",
+			call: func() error { _, err := UnionByEq[int, int](nil, nil, nil, nil); return err },
+			must: func() { _ = UnionByEqMust[int, int](nil, nil, nil, nil) },
+		},
+		{name: "UnionCmp",
+			call: func() error { _, err := UnionCmp[int](nil, nil, nil); return err },
+			must: func() { _ = UnionCmpMust[int](nil, nil, nil) },
+		},
+		{name: "UnionComparer",
+			call: func() error { _, err := UnionComparer[int](nil, nil, *new(EqualityComparer[int])); return err },
+			must: func() { _ = UnionComparerMust[int](nil, nil, *new(EqualityComparer[int])) },
+		},
+		{name: "UnionEq",
+			call: func() error { _, err := UnionEq[int](nil, nil, nil); return err },
+			must: func() { _ = UnionEqMust[int](nil, nil, nil) },
+		},
+		{name: "UnmarshalLookupJSON",
+			call: func() error { _, err := UnmarshalLookupJSON[int, int](nil, nil); return err },
+			must: func() { _ = UnmarshalLookupJSONMust[int, int](nil, nil) },
+		},
+		{name: "WeightedSample",
+			call: func() error { _, err := WeightedSample[int](nil, -1, nil, nil); return err },
+			must: func() { _ = WeightedSampleMust[int](nil, -1, nil, nil) },
+		},
+		{name: "Where",
+			call: func() error { _, err := Where[int](nil, nil); return err },
+			must: func() { _ = WhereMust[int](nil, nil) },
+		},
+		{name: "WhereIdx",
+			call: func() error { _, err := WhereIdx[int](nil, nil); return err },
+			must: func() { _ = WhereIdxMust[int](nil, nil) },
+		},
+		{name: "WriteCSV",
+			call: func() error { _, err := WriteCSV[int](*new(io.Writer), nil, nil); return err },
+			must: func() { _ = WriteCSVMust[int](*new(io.Writer), nil, nil) },
+		},
+		{name: "WriteJSONArray",
+			call: func() error { _, err := WriteJSONArray[int](*new(io.Writer), nil); return err },
+			must: func() { _ = WriteJSONArrayMust[int](*new(io.Writer), nil) },
+		},
+		{name: "WriteJSONLines",
+			call: func() error { _, err := WriteJSONLines[int](*new(io.Writer), nil); return err },
+			must: func() { _ = WriteJSONLinesMust[int](*new(io.Writer), nil) },
+		},
+		{name: "WriteLines",
+			call: func() error { _, err := WriteLines[int](*new(io.Writer), nil, ""); return err },
+			must: func() { _ = WriteLinesMust[int](*new(io.Writer), nil, "") },
+		},
+		{name: "Zip",
+			call: func() error { _, err := Zip[int, int, int](nil, nil, nil); return err },
+			must: func() { _ = ZipMust[int, int, int](nil, nil, nil) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.call()
+			defer func() {
+				got := recover()
+				if want == nil {
+					if got != nil {
+						t.Errorf("%sMust() panicked with %v", tt.name, got)
+					}
+					return
+				}
+				if err, ok := got.(error); !ok || !reflect.DeepEqual(err, want) {
+					t.Errorf("%sMust() panicked with %v, want %v", tt.name, got, want)
+				}
+			}()
+			tt.must()
+		})
+	}
+}
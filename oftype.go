@@ -9,7 +9,7 @@ import (
 // [OfType]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.oftype
 func OfType[Source, Result any](source iter.Seq[Source]) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OfType", "source", ErrNilSource)
 	}
 	return func(yield func(Result) bool) {
 			for s := range source {
@@ -19,7 +19,7 @@ func orderByLsPrim[Source any](source iter.Seq[Source], less func(Source, Source
 // [OrderBy]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.orderby
 func OrderBy[Source cmp.Ordered](source iter.Seq[Source]) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OrderBy", "source", ErrNilSource)
 	}
 	return orderByLsPrim(source, cmp.Less), nil
 }
@@ -29,10 +29,10 @@ func OrderBy[Source cmp.Ordered](source iter.Seq[Source]) (iter.Seq[Source], err
 // [OrderByLs]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.orderby
 func OrderByLs[Source any](source iter.Seq[Source], less func(Source, Source) bool) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OrderByLs", "source", ErrNilSource)
 	}
 	if less == nil {
-		return nil, ErrNilLess
+		return nil, newOperatorError("OrderByLs", "less", ErrNilLess)
 	}
 	return orderByLsPrim(source, less), nil
 }
@@ -42,7 +42,7 @@ func OrderByLs[Source any](source iter.Seq[Source], less func(Source, Source) bo
 // [OrderByDesc]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.orderbydescending
 func OrderByDesc[Source cmp.Ordered](source iter.Seq[Source]) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OrderByDesc", "source", ErrNilSource)
 	}
 	return orderByLsPrim(source, ReverseLess(cmp.Less[Source])), nil
 }
@@ -52,10 +52,10 @@ func OrderByDesc[Source cmp.Ordered](source iter.Seq[Source]) (iter.Seq[Source],
 // [OrderByDescLs]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.orderbydescending
 func OrderByDescLs[Source any](source iter.Seq[Source], less func(Source, Source) bool) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OrderByDescLs", "source", ErrNilSource)
 	}
 	if less == nil {
-		return nil, ErrNilLess
+		return nil, newOperatorError("OrderByDescLs", "less", ErrNilLess)
 	}
 	return orderByLsPrim(source, ReverseLess(less)), nil
 }
@@ -32,10 +32,10 @@ func orderByKeyLsPrim[Source, Key any](source iter.Seq[Source],
 func OrderByKey[Source any, Key cmp.Ordered](source iter.Seq[Source],
 	keySelector func(Source) Key) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OrderByKey", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("OrderByKey", "keySelector", ErrNilSelector)
 	}
 	return orderByKeyLsPrim(source, keySelector, cmp.Less), nil
 }
@@ -46,13 +46,13 @@ func OrderByKey[Source any, Key cmp.Ordered](source iter.Seq[Source],
 func OrderByKeyLs[Source, Key any](source iter.Seq[Source],
 	keySelector func(Source) Key, less func(Key, Key) bool) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OrderByKeyLs", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("OrderByKeyLs", "keySelector", ErrNilSelector)
 	}
 	if less == nil {
-		return nil, ErrNilLess
+		return nil, newOperatorError("OrderByKeyLs", "less", ErrNilLess)
 	}
 	return orderByKeyLsPrim(source, keySelector, less), nil
 }
@@ -63,10 +63,10 @@ func OrderByKeyLs[Source, Key any](source iter.Seq[Source],
 func OrderByKeyDesc[Source any, Key cmp.Ordered](source iter.Seq[Source],
 	keySelector func(Source) Key) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OrderByKeyDesc", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("OrderByKeyDesc", "keySelector", ErrNilSelector)
 	}
 	return orderByKeyLsPrim(source, keySelector, ReverseLess[Key](cmp.Less)), nil
 }
@@ -77,13 +77,13 @@ func OrderByKeyDesc[Source any, Key cmp.Ordered](source iter.Seq[Source],
 func OrderByKeyDescLs[Source, Key any](source iter.Seq[Source],
 	keySelector func(Source) Key, less func(Key, Key) bool) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("OrderByKeyDescLs", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("OrderByKeyDescLs", "keySelector", ErrNilSelector)
 	}
 	if less == nil {
-		return nil, ErrNilLess
+		return nil, newOperatorError("OrderByKeyDescLs", "less", ErrNilLess)
 	}
 	return orderByKeyLsPrim(source, keySelector, ReverseLess[Key](less)), nil
 }
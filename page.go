@@ -0,0 +1,134 @@
+package go2linq
+
+import (
+	"iter"
+	"math"
+)
+
+// maxPagePrealloc limits the capacity preallocated for a caller specified number of elements
+// (e.g. a page's items or a sample).
+const maxPagePrealloc = 1024
+
+// PageResult is a page of a sequence returned by [Page] and [PageNoTotal].
+type PageResult[T any] struct {
+	// Items are the elements of the page.
+	Items []T
+	// Total is the number of elements in the whole sequence (-1 if not counted, see [PageNoTotal]).
+	Total int
+	// HasNext reports whether there are elements after the page.
+	HasNext bool
+}
+
+// Page returns the page number 'page' (1-based) of size 'size' of a sequence
+// along with the total number of elements.
+// 'source' is enumerated once and completely.
+func Page[Source any](source iter.Seq[Source], page, size int) (PageResult[Source], error) {
+	if source == nil {
+		return PageResult[Source]{}, newOperatorError("Page", "source", ErrNilSource)
+	}
+	if page < 1 {
+		return PageResult[Source]{}, newOperatorError("Page", "page", ErrPageOutOfRange)
+	}
+	if size <= 0 {
+		return PageResult[Source]{}, newOperatorError("Page", "size", ErrSizeOutOfRange)
+	}
+	return pageOf(source, page, size, true), nil
+}
+
+// PageNoTotal returns the page number 'page' (1-based) of size 'size' of a sequence
+// without counting the total number of elements ([PageResult.Total] is -1).
+// 'source' is enumerated only up to the first element after the page.
+func PageNoTotal[Source any](source iter.Seq[Source], page, size int) (PageResult[Source], error) {
+	if source == nil {
+		return PageResult[Source]{}, newOperatorError("PageNoTotal", "source", ErrNilSource)
+	}
+	if page < 1 {
+		return PageResult[Source]{}, newOperatorError("PageNoTotal", "page", ErrPageOutOfRange)
+	}
+	if size <= 0 {
+		return PageResult[Source]{}, newOperatorError("PageNoTotal", "size", ErrSizeOutOfRange)
+	}
+	return pageOf(source, page, size, false), nil
+}
+
+// pageOf returns the page of 'source' and counts the total number of elements if 'total' is true.
+func pageOf[Source any](source iter.Seq[Source], page, size int, total bool) PageResult[Source] {
+	// the page's first element index; pages beyond math.MaxInt elements are empty
+	start := math.MaxInt
+	if page-1 <= (math.MaxInt-size)/size {
+		start = (page - 1) * size
+	}
+	r := PageResult[Source]{Items: make([]Source, 0, min(size, maxPagePrealloc)), Total: -1}
+	i := 0
+	for s := range source {
+		switch {
+		case i < start:
+		case len(r.Items) < size:
+			r.Items = append(r.Items, s)
+		default:
+			r.HasNext = true
+			if !total {
+				return r
+			}
+		}
+		i++
+	}
+	if total {
+		r.Total = i
+	}
+	return r
+}
+
+// KeysetPageResult is a page of a sequence returned by [KeysetPage].
+type KeysetPageResult[T, Key any] struct {
+	// Items are the elements of the page.
+	Items []T
+	// HasNext reports whether there are elements after the page.
+	HasNext bool
+	// Next is the key of the last element of the page to be passed as 'after' to get the next page
+	// (zero value if the page is empty).
+	Next Key
+}
+
+// KeysetPage returns up to 'size' elements of a sequence whose keys follow 'after'
+// (or the first 'size' elements if 'after' is nil).
+// Keys are selected by 'keySelector' and compared by 'compare'.
+// Keys of 'source' must be strictly ascending, otherwise [ErrNotSorted] is returned.
+// Unlike [Page], the page boundary is determined by the key, not by the position,
+// so elements added or removed before the cursor do not shift the pages.
+// 'source' is enumerated only up to the first element after the page.
+func KeysetPage[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key,
+	after *Key, size int, compare func(Key, Key) int) (KeysetPageResult[Source, Key], error) {
+	if source == nil {
+		return KeysetPageResult[Source, Key]{}, newOperatorError("KeysetPage", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return KeysetPageResult[Source, Key]{}, newOperatorError("KeysetPage", "keySelector", ErrNilSelector)
+	}
+	if size <= 0 {
+		return KeysetPageResult[Source, Key]{}, newOperatorError("KeysetPage", "size", ErrSizeOutOfRange)
+	}
+	if compare == nil {
+		return KeysetPageResult[Source, Key]{}, newOperatorError("KeysetPage", "compare", ErrNilCompare)
+	}
+	r := KeysetPageResult[Source, Key]{Items: make([]Source, 0, min(size, maxPagePrealloc))}
+	var prev Key
+	first := true
+	for s := range source {
+		key := keySelector(s)
+		if !first && compare(prev, key) >= 0 {
+			return KeysetPageResult[Source, Key]{}, newOperatorError("KeysetPage", "source", ErrNotSorted)
+		}
+		prev, first = key, false
+		if after != nil && compare(key, *after) <= 0 {
+			continue
+		}
+		if len(r.Items) == size {
+			r.HasNext = true
+			break
+		}
+		r.Items = append(r.Items, s)
+		r.Next = key
+	}
+	return r, nil
+}
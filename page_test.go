@@ -0,0 +1,140 @@
+package go2linq
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPage(t *testing.T) {
+	if _, err := Page[int](nil, 1, 1); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Page() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := Page(VarAll(1), 0, 1); !errors.Is(err, ErrPageOutOfRange) {
+		t.Errorf("Page() error = %v, expectedErr %v", err, ErrPageOutOfRange)
+	}
+	if _, err := PageNoTotal(VarAll(1), 1, 0); !errors.Is(err, ErrSizeOutOfRange) {
+		t.Errorf("PageNoTotal() error = %v, expectedErr %v", err, ErrSizeOutOfRange)
+	}
+	tests := []struct {
+		name        string
+		count       int
+		page, size  int
+		wantItems   string
+		wantHasNext bool
+	}{
+		{name: "Empty", count: 0, page: 1, size: 3, wantItems: "[]"},
+		{name: "First", count: 10, page: 1, size: 3, wantItems: "[0 1 2]", wantHasNext: true},
+		{name: "Middle", count: 10, page: 3, size: 3, wantItems: "[6 7 8]", wantHasNext: true},
+		{name: "LastPartial", count: 10, page: 4, size: 3, wantItems: "[9]"},
+		{name: "LastFull", count: 9, page: 3, size: 3, wantItems: "[6 7 8]"},
+		{name: "Beyond", count: 10, page: 5, size: 3, wantItems: "[]"},
+		{name: "Huge", count: 10, page: math.MaxInt, size: math.MaxInt / 2, wantItems: "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := Page(RangeMust(0, tt.count), tt.page, tt.size)
+			if s := StringDef(SliceAll(got.Items)); s != tt.wantItems || got.HasNext != tt.wantHasNext || got.Total != tt.count {
+				t.Errorf("Page() = %v, %v, %v, want %v, %v, %v", s, got.HasNext, got.Total, tt.wantItems, tt.wantHasNext, tt.count)
+			}
+			got, _ = PageNoTotal(RangeMust(0, tt.count), tt.page, tt.size)
+			if s := StringDef(SliceAll(got.Items)); s != tt.wantItems || got.HasNext != tt.wantHasNext || got.Total != -1 {
+				t.Errorf("PageNoTotal() = %v, %v, %v, want %v, %v, -1", s, got.HasNext, got.Total, tt.wantItems, tt.wantHasNext)
+			}
+		})
+	}
+}
+
+func TestPage_singlePass(t *testing.T) {
+	enumerated := 0
+	counted := func(yield func(int) bool) {
+		for i := range 100 {
+			enumerated++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	if Page(counted, 2, 10); enumerated != 100 {
+		t.Errorf("Page() enumerated %d elements, want 100", enumerated)
+	}
+	enumerated = 0
+	if PageNoTotal(counted, 2, 10); enumerated != 21 {
+		t.Errorf("PageNoTotal() enumerated %d elements, want 21", enumerated)
+	}
+}
+
+func TestKeysetPage(t *testing.T) {
+	id := func(p Product) int { return p.Code }
+	if _, err := KeysetPage[Product, int](nil, id, nil, 1, cmp.Compare[int]); !errors.Is(err, ErrNilSource) {
+		t.Errorf("KeysetPage() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := KeysetPage(VarAll(Product{}), nil, nil, 1, cmp.Compare[int]); !errors.Is(err, ErrNilSelector) {
+		t.Errorf("KeysetPage() error = %v, expectedErr %v", err, ErrNilSelector)
+	}
+	if _, err := KeysetPage(VarAll(Product{}), id, nil, 0, cmp.Compare[int]); !errors.Is(err, ErrSizeOutOfRange) {
+		t.Errorf("KeysetPage() error = %v, expectedErr %v", err, ErrSizeOutOfRange)
+	}
+	if _, err := KeysetPage(VarAll(Product{}), id, nil, 1, nil); !errors.Is(err, ErrNilCompare) {
+		t.Errorf("KeysetPage() error = %v, expectedErr %v", err, ErrNilCompare)
+	}
+	unsorted := VarAll(Product{Code: 2}, Product{Code: 1})
+	if _, err := KeysetPage(unsorted, id, nil, 5, cmp.Compare[int]); !errors.Is(err, ErrNotSorted) {
+		t.Errorf("KeysetPage() error = %v, expectedErr %v", err, ErrNotSorted)
+	}
+	codes := VarAll(Product{Code: 10}, Product{Code: 20}, Product{Code: 30}, Product{Code: 40}, Product{Code: 50})
+	after := func(k int) *int { return &k }
+	tests := []struct {
+		name        string
+		after       *int
+		size        int
+		wantItems   string
+		wantHasNext bool
+		wantNext    int
+	}{
+		{name: "First", after: nil, size: 2, wantItems: "[10 20]", wantHasNext: true, wantNext: 20},
+		{name: "Next", after: after(20), size: 2, wantItems: "[30 40]", wantHasNext: true, wantNext: 40},
+		{name: "Last", after: after(40), size: 2, wantItems: "[50]", wantNext: 50},
+		{name: "BetweenKeys", after: after(25), size: 3, wantItems: "[30 40 50]", wantNext: 50},
+		{name: "Beyond", after: after(50), size: 2, wantItems: "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := KeysetPage(codes, id, tt.after, tt.size, cmp.Compare[int])
+			s := StringDef(SelectMust(SliceAll(got.Items), id))
+			if s != tt.wantItems || got.HasNext != tt.wantHasNext || got.Next != tt.wantNext {
+				t.Errorf("KeysetPage() = %v, %v, %v, want %v, %v, %v", s, got.HasNext, got.Next, tt.wantItems, tt.wantHasNext, tt.wantNext)
+			}
+		})
+	}
+}
+
+func ExamplePage() {
+	p, _ := Page(RangeMust(1, 25), 3, 10)
+	fmt.Println(p.Items, p.Total, p.HasNext)
+	// Output:
+	// [21 22 23 24 25] 25 false
+}
+
+func ExampleKeysetPage() {
+	type row struct {
+		id   int
+		name string
+	}
+	rows := VarAll(row{3, "c"}, row{5, "e"}, row{8, "h"}, row{13, "m"}, row{21, "u"})
+	var after *int
+	for {
+		p, _ := KeysetPage(rows, func(r row) int { return r.id }, after, 2, cmp.Compare[int])
+		fmt.Println(p.Items)
+		if !p.HasNext {
+			break
+		}
+		after = &p.Next
+	}
+	// Output:
+	// [{3 c} {5 e}]
+	// [{8 h} {13 m}]
+	// [{21 u}]
+}
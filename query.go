@@ -0,0 +1,195 @@
+package go2linq
+
+import (
+	"iter"
+)
+
+// Query is a wrapper around [iter.Seq] that allows chaining of type-preserving operators.
+// The first error returned by an operator is retained: subsequent operators are not applied
+// and the error is returned by [Query.Err], [Query.All] and [Query.ToSlice].
+// Unlike Query of package query, this Query applies operators immediately and does not optimize them.
+//
+// Since Go methods cannot have type parameters, type-changing operators
+// are implemented as functions (see [QuerySelect], [QueryChunk] etc.).
+type Query[T any] struct {
+	seq iter.Seq[T]
+	err error
+}
+
+// NewQuery returns a [Query] over 'source'.
+func NewQuery[T any](source iter.Seq[T]) Query[T] {
+	if source == nil {
+		return Query[T]{err: newOperatorError("NewQuery", "source", ErrNilSource)}
+	}
+	return Query[T]{seq: source}
+}
+
+// then applies 'op' to the Query's sequence unless the Query already has an error.
+func (c Query[T]) then(op func(iter.Seq[T]) (iter.Seq[T], error)) Query[T] {
+	if c.err != nil {
+		return c
+	}
+	seq, err := op(c.seq)
+	return Query[T]{seq: seq, err: err}
+}
+
+// queryThen applies 'op' to the sequence of 'c' unless 'c' already has an error.
+func queryThen[Source, Result any](c Query[Source], op func(iter.Seq[Source]) (iter.Seq[Result], error)) Query[Result] {
+	if c.err != nil {
+		return Query[Result]{err: c.err}
+	}
+	seq, err := op(c.seq)
+	return Query[Result]{seq: seq, err: err}
+}
+
+// Err returns the first error encountered while building the Query.
+func (c Query[T]) Err() error {
+	return c.err
+}
+
+// All returns the Query's sequence or the first error encountered while building the Query.
+func (c Query[T]) All() (iter.Seq[T], error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.seq, nil
+}
+
+// ToSlice creates a slice from the Query's sequence. (See [ToSlice].)
+func (c Query[T]) ToSlice() ([]T, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return ToSlice(c.seq)
+}
+
+// Where filters the elements based on a 'predicate'. (See [Where].)
+func (c Query[T]) Where(predicate func(T) bool) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Where(s, predicate) })
+}
+
+// WhereIdx filters the elements based on a 'predicate' that uses the element's index. (See [WhereIdx].)
+func (c Query[T]) WhereIdx(predicate func(T, int) bool) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return WhereIdx(s, predicate) })
+}
+
+// Skip bypasses a specified number of elements. (See [Skip].)
+func (c Query[T]) Skip(count int) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Skip(s, count) })
+}
+
+// SkipLast omits the last 'count' elements. (See [SkipLast].)
+func (c Query[T]) SkipLast(count int) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return SkipLast(s, count) })
+}
+
+// SkipWhile bypasses elements as long as a 'predicate' is true. (See [SkipWhile].)
+func (c Query[T]) SkipWhile(predicate func(T) bool) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return SkipWhile(s, predicate) })
+}
+
+// Take returns a specified number of elements from the start. (See [Take].)
+func (c Query[T]) Take(count int) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Take(s, count) })
+}
+
+// TakeLast returns the last 'count' elements. (See [TakeLast].)
+func (c Query[T]) TakeLast(count int) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return TakeLast(s, count) })
+}
+
+// TakeWhile returns elements as long as a 'predicate' is true. (See [TakeWhile].)
+func (c Query[T]) TakeWhile(predicate func(T) bool) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return TakeWhile(s, predicate) })
+}
+
+// Distinct returns distinct elements. (See [Distinct].)
+func (c Query[T]) Distinct() Query[T] {
+	return c.then(Distinct[T])
+}
+
+// DistinctEq returns distinct elements using a specified 'equal' to compare values. (See [DistinctEq].)
+func (c Query[T]) DistinctEq(equal func(T, T) bool) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return DistinctEq(s, equal) })
+}
+
+// OrderBy sorts the elements in ascending order using a specified 'less' function. (See [OrderByLs].)
+func (c Query[T]) OrderBy(less func(T, T) bool) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return OrderByLs(s, less) })
+}
+
+// OrderByDesc sorts the elements in descending order using a specified 'less' function. (See [OrderByDescLs].)
+func (c Query[T]) OrderByDesc(less func(T, T) bool) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return OrderByDescLs(s, less) })
+}
+
+// Concat concatenates the Query's sequence with 'second'. (See [Concat].)
+func (c Query[T]) Concat(second iter.Seq[T]) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Concat(s, second) })
+}
+
+// Union produces the set union of the Query's sequence and 'second'. (See [Union].)
+func (c Query[T]) Union(second iter.Seq[T]) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Union(s, second) })
+}
+
+// Intersect produces the set intersection of the Query's sequence and 'second'. (See [Intersect].)
+func (c Query[T]) Intersect(second iter.Seq[T]) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Intersect(s, second) })
+}
+
+// Except produces the set difference of the Query's sequence and 'second'. (See [Except].)
+func (c Query[T]) Except(second iter.Seq[T]) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Except(s, second) })
+}
+
+// Append appends a value to the end of the Query's sequence. (See [Append].)
+func (c Query[T]) Append(element T) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Append(s, element) })
+}
+
+// Prepend adds a value to the beginning of the Query's sequence. (See [Prepend].)
+func (c Query[T]) Prepend(element T) Query[T] {
+	return c.then(func(s iter.Seq[T]) (iter.Seq[T], error) { return Prepend(s, element) })
+}
+
+// Reverse inverts the order of the elements. (See [Reverse].)
+func (c Query[T]) Reverse() Query[T] {
+	return c.then(Reverse[T])
+}
+
+// DefaultIfEmpty returns the elements or a singleton sequence containing
+// the zero value if the sequence is empty. (See [DefaultIfEmpty].)
+func (c Query[T]) DefaultIfEmpty() Query[T] {
+	return c.then(DefaultIfEmpty[T])
+}
+
+// QuerySelect projects each element of 'c' into a new form. (See [Select].)
+func QuerySelect[Source, Result any](c Query[Source], selector func(Source) Result) Query[Result] {
+	return queryThen(c, func(s iter.Seq[Source]) (iter.Seq[Result], error) { return Select(s, selector) })
+}
+
+// QuerySelectIdx projects each element of 'c' into a new form by incorporating the element's index. (See [SelectIdx].)
+func QuerySelectIdx[Source, Result any](c Query[Source], selector func(Source, int) Result) Query[Result] {
+	return queryThen(c, func(s iter.Seq[Source]) (iter.Seq[Result], error) { return SelectIdx(s, selector) })
+}
+
+// QuerySelectMany projects each element of 'c' to a sequence and flattens the resulting sequences. (See [SelectMany].)
+func QuerySelectMany[Source, Result any](c Query[Source], selector func(Source) iter.Seq[Result]) Query[Result] {
+	return queryThen(c, func(s iter.Seq[Source]) (iter.Seq[Result], error) { return SelectMany(s, selector) })
+}
+
+// QueryChunk splits the elements of 'c' into chunks of size at most 'size'. (See [Chunk].)
+func QueryChunk[Source any](c Query[Source], size int) Query[[]Source] {
+	return queryThen(c, func(s iter.Seq[Source]) (iter.Seq[[]Source], error) { return Chunk(s, size) })
+}
+
+// QueryDistinctBy returns distinct elements of 'c' according to a specified key selector function. (See [DistinctBy].)
+func QueryDistinctBy[Source, Key any](c Query[Source], keySelector func(Source) Key) Query[Source] {
+	return queryThen(c, func(s iter.Seq[Source]) (iter.Seq[Source], error) { return DistinctBy(s, keySelector) })
+}
+
+// QueryOrderByKey sorts the elements of 'c' in ascending order according to a key. (See [OrderByKeyLs].)
+func QueryOrderByKey[Source, Key any](c Query[Source], keySelector func(Source) Key, less func(Key, Key) bool) Query[Source] {
+	return queryThen(c, func(s iter.Seq[Source]) (iter.Seq[Source], error) { return OrderByKeyLs(s, keySelector, less) })
+}
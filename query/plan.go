@@ -0,0 +1,272 @@
+package query
+
+import (
+	"container/heap"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/solsw/go2linq/v4"
+)
+
+type opKind int
+
+const (
+	opSource opKind = iota
+	opSlice
+	opWhere
+	opSelect
+	opSelectWhere
+	opOrderBy
+	opTake
+	opSkip
+	opTopK
+)
+
+// plan is a node of the operator tree.
+// Nodes are never modified after creation, so they may be shared between queries.
+type plan[T any] struct {
+	kind  opKind
+	input *plan[T]
+	// opSource
+	source iter.Seq[T]
+	// opSlice
+	slice []T
+	// opWhere, opSelectWhere
+	predicates []func(T) bool
+	// opSelect, opSelectWhere
+	selector func(T) T
+	// opOrderBy, opTopK
+	less func(T, T) bool
+	desc bool
+	// opTake, opSkip, opTopK
+	count int
+}
+
+// optimize returns the rewritten plan. 'p' itself is not modified.
+// The tree is rewritten bottom-up in one pass: each node is combined with its already optimized input.
+func optimize[T any](p *plan[T]) *plan[T] {
+	if p.input == nil {
+		return p
+	}
+	return rewrite(p, optimize(p.input))
+}
+
+// rewrite combines 'p' with 'in', the already optimized input of 'p'. 'in' is not optimized again.
+func rewrite[T any](p, in *plan[T]) *plan[T] {
+	switch p.kind {
+	case opWhere:
+		switch in.kind {
+		case opWhere:
+			// Where+Where -> Where
+			return &plan[T]{kind: opWhere, input: in.input,
+				predicates: append(slices.Clip(in.predicates), p.predicates...)}
+		case opSelect:
+			// Select+Where -> SelectWhere (the selector is evaluated once per element)
+			return &plan[T]{kind: opSelectWhere, input: in.input, selector: in.selector, predicates: p.predicates}
+		case opSelectWhere:
+			// SelectWhere+Where -> SelectWhere
+			return &plan[T]{kind: opSelectWhere, input: in.input, selector: in.selector,
+				predicates: append(slices.Clip(in.predicates), p.predicates...)}
+		case opOrderBy:
+			// OrderBy+Where -> Where+OrderBy (fewer elements to sort)
+			where := rewrite(&plan[T]{kind: opWhere, predicates: p.predicates}, in.input)
+			return &plan[T]{kind: opOrderBy, input: where, less: in.less, desc: in.desc}
+		}
+	case opTake:
+		if in.kind == opOrderBy {
+			// OrderBy+Take -> TopK
+			return &plan[T]{kind: opTopK, input: in.input, less: in.less, desc: in.desc, count: p.count}
+		}
+	}
+	if in == p.input {
+		return p
+	}
+	q := *p
+	q.input = in
+	return &q
+}
+
+// countable returns the number of elements produced by 'p' if it can be computed without enumeration.
+func (p *plan[T]) countable() (int, bool) {
+	switch p.kind {
+	case opSlice:
+		return len(p.slice), true
+	case opSelect, opOrderBy:
+		return p.input.countable()
+	case opTake, opTopK:
+		c, ok := p.input.countable()
+		return min(max(p.count, 0), c), ok
+	case opSkip:
+		c, ok := p.input.countable()
+		return max(c-max(p.count, 0), 0), ok
+	}
+	return 0, false
+}
+
+func (p *plan[T]) orderLess() func(T, T) bool {
+	if p.desc {
+		return go2linq.ReverseLess(p.less)
+	}
+	return p.less
+}
+
+// seq returns the sequence produced by 'p'.
+func (p *plan[T]) seq() iter.Seq[T] {
+	var r iter.Seq[T]
+	switch p.kind {
+	case opSource:
+		r = p.source
+	case opSlice:
+		r = go2linq.SliceAll(p.slice)
+	case opWhere:
+		r, _ = go2linq.Where(p.input.seq(), allOf(p.predicates))
+	case opSelect:
+		r, _ = go2linq.Select(p.input.seq(), p.selector)
+	case opSelectWhere:
+		sel, pred := p.selector, allOf(p.predicates)
+		input := p.input.seq()
+		r = func(yield func(T) bool) {
+			for t := range input {
+				if s := sel(t); pred(s) && !yield(s) {
+					return
+				}
+			}
+		}
+	case opOrderBy:
+		r, _ = go2linq.OrderByLs(p.input.seq(), p.orderLess())
+	case opTake:
+		r, _ = go2linq.Take(p.input.seq(), p.count)
+	case opSkip:
+		r, _ = go2linq.Skip(p.input.seq(), p.count)
+	case opTopK:
+		r = topK(p.input.seq(), p.count, p.orderLess())
+	}
+	return r
+}
+
+// allOf returns a predicate that is true if all 'predicates' are true.
+func allOf[T any](predicates []func(T) bool) func(T) bool {
+	return func(t T) bool {
+		for _, pred := range predicates {
+			if !pred(t) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+type indexed[T any] struct {
+	t T
+	i int
+}
+
+// worstHeap keeps the worst element (according to 'less' and then to index) on top.
+type worstHeap[T any] struct {
+	ee   []indexed[T]
+	less func(T, T) bool
+}
+
+func (h *worstHeap[T]) Len() int { return len(h.ee) }
+func (h *worstHeap[T]) Less(i, j int) bool {
+	return h.before(h.ee[j], h.ee[i])
+}
+func (h *worstHeap[T]) Swap(i, j int) { h.ee[i], h.ee[j] = h.ee[j], h.ee[i] }
+func (h *worstHeap[T]) Push(x any)    { h.ee = append(h.ee, x.(indexed[T])) }
+func (h *worstHeap[T]) Pop() any {
+	x := h.ee[len(h.ee)-1]
+	h.ee = h.ee[:len(h.ee)-1]
+	return x
+}
+
+// before determines whether 'x' precedes 'y' in the stable order.
+func (h *worstHeap[T]) before(x, y indexed[T]) bool {
+	if h.less(x.t, y.t) {
+		return true
+	}
+	if h.less(y.t, x.t) {
+		return false
+	}
+	return x.i < y.i
+}
+
+// topK returns the first 'k' elements of stably sorted 'source' using a heap of size 'k'.
+func topK[T any](source iter.Seq[T], k int, less func(T, T) bool) iter.Seq[T] {
+	if k <= 0 {
+		return go2linq.Empty[T]()
+	}
+	return func(yield func(T) bool) {
+		h := &worstHeap[T]{ee: make([]indexed[T], 0, k), less: less}
+		i := 0
+		for t := range source {
+			switch {
+			case h.Len() < k:
+				heap.Push(h, indexed[T]{t: t, i: i})
+			case less(t, h.ee[0].t):
+				h.ee[0] = indexed[T]{t: t, i: i}
+				heap.Fix(h, 0)
+			}
+			i++
+		}
+		slices.SortFunc(h.ee, func(x, y indexed[T]) int {
+			if h.before(x, y) {
+				return -1
+			}
+			return 1
+		})
+		for _, e := range h.ee {
+			if !yield(e.t) {
+				return
+			}
+		}
+	}
+}
+
+func (p *plan[T]) describe() string {
+	switch p.kind {
+	case opSource:
+		return "Source"
+	case opSlice:
+		return fmt.Sprintf("Slice(len=%d)", len(p.slice))
+	case opWhere:
+		if len(p.predicates) > 1 {
+			return fmt.Sprintf("Where(%d predicates)", len(p.predicates))
+		}
+		return "Where"
+	case opSelect:
+		return "Select"
+	case opSelectWhere:
+		if len(p.predicates) > 1 {
+			return fmt.Sprintf("SelectWhere(%d predicates)", len(p.predicates))
+		}
+		return "SelectWhere"
+	case opOrderBy:
+		if p.desc {
+			return "OrderByDesc"
+		}
+		return "OrderBy"
+	case opTake:
+		return fmt.Sprintf("Take(%d)", p.count)
+	case opSkip:
+		return fmt.Sprintf("Skip(%d)", p.count)
+	case opTopK:
+		if p.desc {
+			return fmt.Sprintf("TopKDesc(%d)", p.count)
+		}
+		return fmt.Sprintf("TopK(%d)", p.count)
+	}
+	return "?"
+}
+
+// explain writes the operator tree starting from the last operator.
+func (p *plan[T]) explain(b *strings.Builder) {
+	depth := 0
+	for n := p; n != nil; n = n.input {
+		b.WriteString(strings.Repeat("  ", depth))
+		b.WriteString(n.describe())
+		b.WriteByte('\n')
+		depth++
+	}
+}
@@ -0,0 +1,153 @@
+// Package query implements an opt-in query plan layer over go2linq operators.
+//
+// Unlike go2linq operators, which immediately return opaque sequences,
+// [Query] builds a tree of operators. Before execution the tree is rewritten
+// by an optimizer in a single bottom-up pass:
+//   - consecutive Where operators are fused into one;
+//   - Where is pushed below OrderBy, so fewer elements are sorted;
+//   - Select followed by Where is fused into one operator that evaluates the selector once per element
+//     (Where is not pushed below Select, since its predicate sees the projected elements);
+//   - OrderBy followed by Take is replaced by a heap based top-K selection;
+//   - Count over random-access sources (see [FromSlice]) is computed without enumeration where possible.
+package query
+
+import (
+	"iter"
+	"strings"
+
+	"github.com/solsw/go2linq/v4"
+)
+
+// Query represents a query over a sequence of 'T'.
+// Query is immutable: each method returns a new Query.
+// The first error encountered while building the query is retained
+// and returned by the terminal methods.
+type Query[T any] struct {
+	p   *plan[T]
+	err error
+}
+
+// From returns a [Query] over 'source'.
+func From[T any](source iter.Seq[T]) *Query[T] {
+	if source == nil {
+		return &Query[T]{err: &go2linq.OperatorError{Op: "query.From", Arg: "source", Err: go2linq.ErrNilSource}}
+	}
+	return &Query[T]{p: &plan[T]{kind: opSource, source: source}}
+}
+
+// FromSlice returns a [Query] over the random-access slice 's'.
+func FromSlice[S ~[]T, T any](s S) *Query[T] {
+	return &Query[T]{p: &plan[T]{kind: opSlice, slice: s}}
+}
+
+func (q *Query[T]) with(p *plan[T], err error) *Query[T] {
+	if q.err != nil {
+		return q
+	}
+	if err != nil {
+		return &Query[T]{err: err}
+	}
+	p.input = q.p
+	return &Query[T]{p: p}
+}
+
+// Where filters the elements based on a 'predicate'. (See [go2linq.Where].)
+func (q *Query[T]) Where(predicate func(T) bool) *Query[T] {
+	var err error
+	if predicate == nil {
+		err = &go2linq.OperatorError{Op: "Query.Where", Arg: "predicate", Err: go2linq.ErrNilPredicate}
+	}
+	return q.with(&plan[T]{kind: opWhere, predicates: []func(T) bool{predicate}}, err)
+}
+
+// Select projects each element using a 'selector'. (See [go2linq.Select].)
+func (q *Query[T]) Select(selector func(T) T) *Query[T] {
+	var err error
+	if selector == nil {
+		err = &go2linq.OperatorError{Op: "Query.Select", Arg: "selector", Err: go2linq.ErrNilSelector}
+	}
+	return q.with(&plan[T]{kind: opSelect, selector: selector}, err)
+}
+
+// OrderBy sorts the elements in ascending order using a specified 'less' function. (See [go2linq.OrderByLs].)
+func (q *Query[T]) OrderBy(less func(T, T) bool) *Query[T] {
+	var err error
+	if less == nil {
+		err = &go2linq.OperatorError{Op: "Query.OrderBy", Arg: "less", Err: go2linq.ErrNilLess}
+	}
+	return q.with(&plan[T]{kind: opOrderBy, less: less}, err)
+}
+
+// OrderByDesc sorts the elements in descending order using a specified 'less' function. (See [go2linq.OrderByDescLs].)
+func (q *Query[T]) OrderByDesc(less func(T, T) bool) *Query[T] {
+	var err error
+	if less == nil {
+		err = &go2linq.OperatorError{Op: "Query.OrderByDesc", Arg: "less", Err: go2linq.ErrNilLess}
+	}
+	return q.with(&plan[T]{kind: opOrderBy, less: less, desc: true}, err)
+}
+
+// Take returns a specified number of contiguous elements from the start. (See [go2linq.Take].)
+func (q *Query[T]) Take(count int) *Query[T] {
+	return q.with(&plan[T]{kind: opTake, count: count}, nil)
+}
+
+// Skip bypasses a specified number of elements and then returns the remaining elements. (See [go2linq.Skip].)
+func (q *Query[T]) Skip(count int) *Query[T] {
+	return q.with(&plan[T]{kind: opSkip, count: count}, nil)
+}
+
+// Err returns the first error encountered while building the query.
+func (q *Query[T]) Err() error {
+	return q.err
+}
+
+// Optimize returns the query with the optimized operator tree.
+// Terminal methods optimize the query automatically.
+func (q *Query[T]) Optimize() *Query[T] {
+	if q.err != nil {
+		return q
+	}
+	return &Query[T]{p: optimize(q.p)}
+}
+
+// All returns the sequence produced by the optimized query.
+func (q *Query[T]) All() (iter.Seq[T], error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return optimize(q.p).seq(), nil
+}
+
+// ToSlice returns the result of the optimized query as a slice.
+func (q *Query[T]) ToSlice() ([]T, error) {
+	seq, err := q.All()
+	if err != nil {
+		return nil, err
+	}
+	return go2linq.ToSlice(seq)
+}
+
+// Count returns the number of elements produced by the query.
+// If the number can be computed from the random-access source, the source is not enumerated.
+func (q *Query[T]) Count() (int, error) {
+	if q.err != nil {
+		return -1, q.err
+	}
+	p := optimize(q.p)
+	if c, ok := p.countable(); ok {
+		return c, nil
+	}
+	return go2linq.Count(p.seq())
+}
+
+// Explain returns the description of the optimized operator tree,
+// one operator per line, starting from the last operator.
+func (q *Query[T]) Explain() string {
+	if q.err != nil {
+		return "Error: " + q.err.Error() + "\n"
+	}
+	var b strings.Builder
+	optimize(q.p).explain(&b)
+	return b.String()
+}
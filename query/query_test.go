@@ -0,0 +1,241 @@
+package query
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/solsw/go2linq/v4"
+)
+
+func intLess(x, y int) bool { return x < y }
+
+// counting returns a sequence over 's' which counts enumerated elements in 'n'.
+func counting(s []int, n *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, i := range s {
+			*n++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestQuery_Explain(t *testing.T) {
+	rng, _ := go2linq.Range(1, 10)
+	tests := []struct {
+		name string
+		q    *Query[int]
+		want string
+	}{
+		{name: "WhereWhere",
+			q:    From(rng).Where(func(i int) bool { return i > 2 }).Where(func(i int) bool { return i < 8 }),
+			want: "Where(2 predicates)\n  Source\n",
+		},
+		{name: "OrderByTake",
+			q:    From(rng).OrderBy(intLess).Take(3),
+			want: "TopK(3)\n  Source\n",
+		},
+		{name: "SelectWhere",
+			q:    From(rng).Select(func(i int) int { return i * 2 }).Where(func(i int) bool { return i > 10 }),
+			want: "SelectWhere\n  Source\n",
+		},
+		{name: "SelectWhereWhere",
+			q: From(rng).Select(func(i int) int { return i * 2 }).
+				Where(func(i int) bool { return i > 10 }).Where(func(i int) bool { return i < 16 }),
+			want: "SelectWhere(2 predicates)\n  Source\n",
+		},
+		{name: "WhereOrderByWhere",
+			q: From(rng).Where(func(i int) bool { return i > 2 }).
+				OrderBy(intLess).Where(func(i int) bool { return i < 8 }),
+			want: "OrderBy\n  Where(2 predicates)\n    Source\n",
+		},
+		{name: "OrderByOrderByWhere",
+			q:    From(rng).OrderBy(intLess).OrderByDesc(intLess).Where(func(i int) bool { return i < 8 }),
+			want: "OrderByDesc\n  OrderBy\n    Where\n      Source\n",
+		},
+		{name: "All",
+			q: FromSlice([]int{5, 3, 8}).
+				Where(func(i int) bool { return i > 1 }).
+				OrderByDesc(intLess).
+				Select(func(i int) int { return i + 1 }).
+				Where(func(i int) bool { return i%2 == 0 }).
+				Take(2),
+			want: "Take(2)\n  SelectWhere\n    OrderByDesc\n      Where\n        Slice(len=3)\n",
+		},
+		{name: "Error",
+			q:    From(rng).Where(nil).Take(3),
+			want: "Error: Query.Where: 'predicate': nil predicate\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.Explain(); got != tt.want {
+				t.Errorf("Explain() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_ToSlice(t *testing.T) {
+	data := []int{7, 2, 9, 4, 2, 8, 1, 9, 5}
+	tests := []struct {
+		name string
+		q    *Query[int]
+		want []int
+	}{
+		{name: "WhereWhere",
+			q:    FromSlice(data).Where(func(i int) bool { return i > 2 }).Where(func(i int) bool { return i < 9 }),
+			want: []int{7, 4, 8, 5},
+		},
+		{name: "TopK",
+			q:    FromSlice(data).OrderBy(intLess).Take(4),
+			want: []int{1, 2, 2, 4},
+		},
+		{name: "TopKDesc",
+			q:    FromSlice(data).OrderByDesc(intLess).Take(3),
+			want: []int{9, 9, 8},
+		},
+		{name: "TopKZero",
+			q:    FromSlice(data).OrderBy(intLess).Take(0),
+			want: []int{},
+		},
+		{name: "TopKMoreThanLen",
+			q:    FromSlice(data).OrderBy(intLess).Take(100),
+			want: []int{1, 2, 2, 4, 5, 7, 8, 9, 9},
+		},
+		{name: "PushedWhere",
+			q: FromSlice(data).OrderBy(intLess).
+				Select(func(i int) int { return i * 10 }).
+				Where(func(i int) bool { return i >= 50 }).
+				Skip(1).Take(2),
+			want: []int{70, 80},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.q.ToSlice()
+			if err != nil {
+				t.Fatalf("ToSlice() error = %v", err)
+			}
+			if equal, _ := go2linq.SequenceEqual(go2linq.SliceAll(got), go2linq.SliceAll(tt.want)); !equal {
+				t.Errorf("ToSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_SelectWhereOnce(t *testing.T) {
+	calls := 0
+	q := FromSlice([]int{1, 2, 3, 4, 5}).
+		Select(func(i int) int { calls++; return i * i }).
+		Where(func(i int) bool { return i%2 == 1 }).
+		Where(func(i int) bool { return i > 1 })
+	got, err := q.ToSlice()
+	if err != nil {
+		t.Fatalf("ToSlice() error = %v", err)
+	}
+	if equal, _ := go2linq.SequenceEqual(go2linq.SliceAll(got), go2linq.VarAll(9, 25)); !equal {
+		t.Errorf("ToSlice() = %v, want [9 25]", got)
+	}
+	if calls != 5 {
+		t.Errorf("selector called %d times, want 5", calls)
+	}
+}
+
+func TestQuery_TopKStable(t *testing.T) {
+	type kv struct {
+		k int
+		v string
+	}
+	data := []kv{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}, {2, "e"}, {1, "f"}}
+	less := func(x, y kv) bool { return x.k < y.k }
+	want, _ := go2linq.OrderByLs(go2linq.SliceAll(data), less)
+	want, _ = go2linq.Take(want, 4)
+	got, _ := FromSlice(data).OrderBy(less).Take(4).All()
+	if equal, _ := go2linq.SequenceEqual(got, want); !equal {
+		t.Errorf("TopK = %v, want %v", go2linq.StringDef(got), go2linq.StringDef(want))
+	}
+}
+
+func TestQuery_Count(t *testing.T) {
+	data := []int{7, 2, 9, 4, 2, 8, 1, 9, 5}
+	n := 0
+	tests := []struct {
+		name      string
+		q         *Query[int]
+		want      int
+		enumerate bool
+	}{
+		{name: "Slice", q: FromSlice(data), want: 9},
+		{name: "SelectOrderBy", q: FromSlice(data).Select(func(i int) int { return -i }).OrderBy(intLess), want: 9},
+		{name: "SkipTake", q: FromSlice(data).Skip(3).Take(4), want: 4},
+		{name: "SkipTooMany", q: FromSlice(data).Skip(20), want: 0},
+		{name: "TopK", q: FromSlice(data).OrderBy(intLess).Take(20), want: 9},
+		{name: "Where", q: From(counting(data, &n)).Where(func(i int) bool { return i > 4 }), want: 5, enumerate: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n = 0
+			got, err := tt.q.Count()
+			if err != nil {
+				t.Fatalf("Count() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Count() = %v, want %v", got, tt.want)
+			}
+			if (n > 0) != tt.enumerate {
+				t.Errorf("Count() enumerated %d elements", n)
+			}
+		})
+	}
+}
+
+func TestQuery_error(t *testing.T) {
+	tests := []struct {
+		name        string
+		q           *Query[int]
+		expectedErr error
+	}{
+		{name: "NilSource", q: From[int](nil).Take(1), expectedErr: go2linq.ErrNilSource},
+		{name: "NilPredicate", q: FromSlice([]int{1}).Where(nil), expectedErr: go2linq.ErrNilPredicate},
+		{name: "NilSelector", q: FromSlice([]int{1}).Select(nil).Where(nil), expectedErr: go2linq.ErrNilSelector},
+		{name: "NilLess", q: FromSlice([]int{1}).OrderBy(nil), expectedErr: go2linq.ErrNilLess},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.q.Err(); !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Err() = %v, expectedErr %v", err, tt.expectedErr)
+			}
+			if _, err := tt.q.ToSlice(); !errors.Is(err, tt.expectedErr) {
+				t.Errorf("ToSlice() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+			if _, err := tt.q.Count(); !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Count() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func ExampleQuery_Explain() {
+	type Employee struct {
+		Name   string
+		Salary int
+	}
+	employees := []Employee{{"Alice", 5000}, {"Bob", 3000}, {"Carl", 4500}, {"Dora", 4000}}
+	q := FromSlice(employees).
+		OrderByDesc(func(x, y Employee) bool { return cmp.Less(x.Salary, y.Salary) }).
+		Where(func(e Employee) bool { return e.Name != "Alice" }).
+		Take(2)
+	fmt.Print(q.Explain())
+	top, _ := q.ToSlice()
+	fmt.Println(top)
+	// Output:
+	// TopKDesc(2)
+	//   Where
+	//     Slice(len=4)
+	// [{Carl 4500} {Dora 4000}]
+}
@@ -0,0 +1,134 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	got, err := NewQuery(RangeMust(1, 10)).
+		Where(func(i int) bool { return i%2 == 0 }).
+		OrderByDesc(func(i, j int) bool { return i < j }).
+		Skip(1).
+		Append(0).
+		ToSlice()
+	if err != nil {
+		t.Fatalf("Query.ToSlice() error = %v", err)
+	}
+	if s := fmt.Sprint(got); s != "[8 6 4 2 0]" {
+		t.Errorf("Query.ToSlice() = %v, want [8 6 4 2 0]", s)
+	}
+}
+
+func TestQuery_stickyError(t *testing.T) {
+	called := false
+	q := NewQuery(VarAll(1, 2, 3)).
+		Where(nil).
+		Where(func(int) bool { called = true; return true })
+	if !errors.Is(q.Err(), ErrNilPredicate) {
+		t.Fatalf("Query.Err() = %v, want %v", q.Err(), ErrNilPredicate)
+	}
+	var oe *OperatorError
+	if !errors.As(q.Err(), &oe) || oe.Op != "Where" {
+		t.Errorf("Query.Err() = %v, want error from Where", q.Err())
+	}
+	q2 := QuerySelect(q, func(i int) string { return fmt.Sprint(i) }).Take(1)
+	if !errors.Is(q2.Err(), ErrNilPredicate) {
+		t.Errorf("QuerySelect().Err() = %v, want %v", q2.Err(), ErrNilPredicate)
+	}
+	if _, err := q2.ToSlice(); !errors.Is(err, ErrNilPredicate) {
+		t.Errorf("Query.ToSlice() error = %v, want %v", err, ErrNilPredicate)
+	}
+	if seq, err := q.All(); seq != nil || !errors.Is(err, ErrNilPredicate) {
+		t.Errorf("Query.All() error = %v, want %v", err, ErrNilPredicate)
+	}
+	if called {
+		t.Errorf("Where predicate was called after error")
+	}
+	if err := NewQuery[int](nil).Err(); !errors.Is(err, ErrNilSource) {
+		t.Errorf("NewQuery() error = %v, want %v", err, ErrNilSource)
+	}
+}
+
+func TestQuery_methods(t *testing.T) {
+	tests := []struct {
+		name string
+		q    Query[int]
+		want string
+	}{
+		{name: "WhereIdx", q: NewQuery(VarAll(5, 6, 7)).WhereIdx(func(_, i int) bool { return i != 1 }), want: "[5 7]"},
+		{name: "SkipLast", q: NewQuery(VarAll(1, 2, 3)).SkipLast(2), want: "[1]"},
+		{name: "SkipWhile", q: NewQuery(VarAll(1, 2, 3, 1)).SkipWhile(func(i int) bool { return i < 2 }), want: "[2 3 1]"},
+		{name: "TakeLast", q: NewQuery(VarAll(1, 2, 3)).TakeLast(2), want: "[2 3]"},
+		{name: "TakeWhile", q: NewQuery(VarAll(1, 2, 3, 1)).TakeWhile(func(i int) bool { return i < 3 }), want: "[1 2]"},
+		{name: "Distinct", q: NewQuery(VarAll(1, 2, 1, 3, 2)).Distinct(), want: "[1 2 3]"},
+		{name: "DistinctEq", q: NewQuery(VarAll(1, 3, 2, 4)).DistinctEq(func(i, j int) bool { return i%2 == j%2 }), want: "[1 2]"},
+		{name: "OrderBy", q: NewQuery(VarAll(3, 1, 2)).OrderBy(func(i, j int) bool { return i < j }), want: "[1 2 3]"},
+		{name: "Concat", q: NewQuery(VarAll(1, 2)).Concat(VarAll(2, 3)), want: "[1 2 2 3]"},
+		{name: "Union", q: NewQuery(VarAll(1, 2)).Union(VarAll(2, 3)), want: "[1 2 3]"},
+		{name: "Intersect", q: NewQuery(VarAll(1, 2)).Intersect(VarAll(2, 3)), want: "[2]"},
+		{name: "Except", q: NewQuery(VarAll(1, 2)).Except(VarAll(2, 3)), want: "[1]"},
+		{name: "Prepend", q: NewQuery(VarAll(1, 2)).Prepend(0), want: "[0 1 2]"},
+		{name: "Reverse", q: NewQuery(VarAll(1, 2, 3)).Reverse(), want: "[3 2 1]"},
+		{name: "DefaultIfEmpty", q: NewQuery(Empty[int]()).DefaultIfEmpty(), want: "[0]"},
+		{name: "DistinctBy", q: QueryDistinctBy(NewQuery(VarAll(1, 3, 2, 4)), func(i int) int { return i % 2 }), want: "[1 2]"},
+		{name: "OrderByKey", q: QueryOrderByKey(NewQuery(VarAll(-3, 1, -2)), func(i int) int { return i * i },
+			func(i, j int) bool { return i < j }), want: "[1 -2 -3]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.q.Err(); err != nil {
+				t.Fatalf("Query.Err() = %v", err)
+			}
+			seq, err := tt.q.All()
+			if err != nil {
+				t.Fatalf("Query.All() error = %v", err)
+			}
+			if got := StringDef(seq); got != tt.want {
+				t.Errorf("Query.All() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_typeChanging(t *testing.T) {
+	words := NewQuery(VarAll("alpha", "beta", "gamma"))
+	if got, _ := QuerySelectIdx(words, func(s string, i int) string { return fmt.Sprint(i, s[:1]) }).ToSlice(); fmt.Sprint(got) != "[0a 1b 2g]" {
+		t.Errorf("QuerySelectIdx() = %v", got)
+	}
+	letters := QuerySelectMany(words.Take(2), func(s string) iter.Seq[rune] { return VarAll([]rune(s)...) })
+	if got, _ := letters.Distinct().ToSlice(); fmt.Sprint(got) != "[97 108 112 104 98 101 116]" {
+		t.Errorf("QuerySelectMany() = %v", got)
+	}
+	if got, _ := QueryChunk(words, 2).ToSlice(); fmt.Sprint(got) != "[[alpha beta] [gamma]]" {
+		t.Errorf("QueryChunk() = %v", got)
+	}
+	if err := QueryChunk(words, 0).Err(); !errors.Is(err, ErrSizeOutOfRange) {
+		t.Errorf("QueryChunk() error = %v", err)
+	}
+}
+
+func ExampleQuery() {
+	words := NewQuery(VarAll("one", "two", "three", "four", "five", "six")).
+		Where(func(s string) bool { return len(s) > 3 }).
+		OrderBy(func(s1, s2 string) bool { return s1 < s2 })
+	upper := QuerySelect(words, strings.ToUpper)
+	seq, err := upper.All()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for s := range seq {
+		fmt.Println(s)
+	}
+	_, err = upper.SkipWhile(nil).Take(1).ToSlice()
+	fmt.Println(err)
+	// Output:
+	// FIVE
+	// FOUR
+	// THREE
+	// SkipWhile: 'predicate': nil predicate
+}
@@ -0,0 +1,46 @@
+package go2linq
+
+import (
+	"iter"
+)
+
+// RangeStep generates a sequence of numbers from 'start' (inclusive) to 'stop' (exclusive)
+// incremented by 'step'. If 'step' is negative, the sequence is descending.
+// Floating-point elements are computed as start+i*step, so rounding errors do not accumulate.
+// Integer sequences stop before overflow.
+func RangeStep[T Number](start, stop, step T) (iter.Seq[T], error) {
+	if !(step > 0 || step < 0) {
+		return nil, newOperatorError("RangeStep", "step", ErrZeroStep)
+	}
+	inRange := func(v T) bool {
+		if step > 0 {
+			return v < stop
+		}
+		return v > stop
+	}
+	if isFloat[T]() {
+		return func(yield func(T) bool) {
+				for i := 0; ; i++ {
+					v := start + T(i)*step
+					if !inRange(v) || !yield(v) {
+						return
+					}
+				}
+			},
+			nil
+	}
+	return func(yield func(T) bool) {
+			for v := start; inRange(v); {
+				if !yield(v) {
+					return
+				}
+				next := v + step
+				if (step > 0) != (next > v) {
+					// overflow
+					return
+				}
+				v = next
+			}
+		},
+		nil
+}
@@ -0,0 +1,76 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestRangeStep_int(t *testing.T) {
+	if _, err := RangeStep(0, 10, 0); !errors.Is(err, ErrZeroStep) {
+		t.Errorf("RangeStep() error = %v, expectedErr %v", err, ErrZeroStep)
+	}
+	tests := []struct {
+		name              string
+		start, stop, step int
+		want              string
+	}{
+		{name: "Ascending", start: 0, stop: 10, step: 3, want: "[0 3 6 9]"},
+		{name: "Descending", start: 10, stop: 0, step: -3, want: "[10 7 4 1]"},
+		{name: "StopExcluded", start: 0, stop: 6, step: 2, want: "[0 2 4]"},
+		{name: "EmptyAscending", start: 5, stop: 5, step: 1, want: "[]"},
+		{name: "WrongDirection", start: 0, stop: 10, step: -1, want: "[]"},
+		{name: "Overflow", start: math.MaxInt - 5, stop: math.MaxInt, step: 4,
+			want: fmt.Sprintf("[%d %d]", math.MaxInt-5, math.MaxInt-1)},
+		{name: "NegativeOverflow", start: math.MinInt + 5, stop: math.MinInt, step: -3,
+			want: fmt.Sprintf("[%d %d]", math.MinInt+5, math.MinInt+2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := RangeStep(tt.start, tt.stop, tt.step)
+			if s := StringDef(got); s != tt.want {
+				t.Errorf("RangeStep() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeStep_uint8(t *testing.T) {
+	got, _ := RangeStep[uint8](250, 255, 2)
+	if s := StringDef(got); s != "[250 252 254]" {
+		t.Errorf("RangeStep() = %v, want [250 252 254]", s)
+	}
+	got, _ = RangeStep[uint8](0, 255, 200)
+	if s := StringDef(got); s != "[0 200]" {
+		t.Errorf("RangeStep() = %v, want [0 200]", s)
+	}
+}
+
+func TestRangeStep_float(t *testing.T) {
+	if _, err := RangeStep(0, 1, math.NaN()); !errors.Is(err, ErrZeroStep) {
+		t.Errorf("RangeStep() error = %v, expectedErr %v", err, ErrZeroStep)
+	}
+	got, _ := RangeStep(0, 1, 0.1)
+	if n, _ := Count(got); n != 10 {
+		t.Errorf("Count(RangeStep(0, 1, 0.1)) = %v, want 10", n)
+	}
+	last, _ := Last(got)
+	if math.Abs(last-0.9) > 1e-12 {
+		t.Errorf("Last(RangeStep(0, 1, 0.1)) = %v, want 0.9", last)
+	}
+	got, _ = RangeStep(1.0, -1.0, -0.5)
+	if s := StringDef(got); s != "[1 0.5 0 -0.5]" {
+		t.Errorf("RangeStep() = %v, want [1 0.5 0 -0.5]", s)
+	}
+}
+
+func ExampleRangeStep() {
+	odd, _ := RangeStep(1, 10, 2)
+	fmt.Println(StringDef(odd))
+	countdown, _ := RangeStep(1.0, 0.0, -0.25)
+	fmt.Println(StringDef(countdown))
+	// Output:
+	// [1 3 5 7 9]
+	// [1 0.75 0.5 0.25]
+}
@@ -0,0 +1,236 @@
+package go2linq
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+)
+
+// Lines returns a sequence2 of lines read from 'r' and read errors.
+// Line terminators are stripped (see [bufio.ScanLines]).
+// Lines longer than [bufio.MaxScanTokenSize] end the sequence2 with [bufio.ErrTooLong].
+// (See [Split].)
+func Lines(r io.Reader) (iter.Seq2[string, error], error) {
+	if r == nil {
+		return nil, newOperatorError("Lines", "r", ErrNilSource)
+	}
+	return Split(r, bufio.ScanLines)
+}
+
+// Split returns a sequence2 of tokens read from 'r' using a 'split' function and read errors.
+// 'r' is read lazily as the sequence2 is enumerated, so the sequence2 can be enumerated only once.
+// If reading fails, the error is yielded with an empty token and the enumeration is stopped.
+// Tokens are limited to [bufio.MaxScanTokenSize] (64 KiB) as with [bufio.Scanner];
+// a longer token results in [bufio.ErrTooLong] error.
+func Split(r io.Reader, split bufio.SplitFunc) (iter.Seq2[string, error], error) {
+	if r == nil {
+		return nil, newOperatorError("Split", "r", ErrNilSource)
+	}
+	if split == nil {
+		return nil, newOperatorError("Split", "split", ErrNilSplit)
+	}
+	return func(yield func(string, error) bool) {
+			sc := bufio.NewScanner(r)
+			sc.Split(split)
+			for sc.Scan() {
+				if !yield(sc.Text(), nil) {
+					return
+				}
+			}
+			if err := sc.Err(); err != nil {
+				yield("", err)
+			}
+		},
+		nil
+}
+
+// CSVOptions configures [CSVRecords]. Fields correspond to the same named fields of [csv.Reader].
+type CSVOptions struct {
+	Comma            rune
+	Comment          rune
+	FieldsPerRecord  int
+	LazyQuotes       bool
+	TrimLeadingSpace bool
+}
+
+// CSVRecords returns a sequence2 of [CSV] records read from 'r' and read errors.
+// If 'opts' is nil, [csv.Reader] defaults are used.
+// 'r' is read lazily as the sequence2 is enumerated, so the sequence2 can be enumerated only once.
+// Records with wrong number of fields are yielded together with [csv.ErrFieldCount] error.
+// If any other error occurs, it is yielded with nil record and the enumeration is stopped.
+//
+// [CSV]: https://www.rfc-editor.org/rfc/rfc4180.html
+func CSVRecords(r io.Reader, opts *CSVOptions) (iter.Seq2[[]string, error], error) {
+	if r == nil {
+		return nil, newOperatorError("CSVRecords", "r", ErrNilSource)
+	}
+	return func(yield func([]string, error) bool) {
+			cr := csv.NewReader(r)
+			if opts != nil {
+				if opts.Comma != 0 {
+					cr.Comma = opts.Comma
+				}
+				cr.Comment = opts.Comment
+				cr.FieldsPerRecord = opts.FieldsPerRecord
+				cr.LazyQuotes = opts.LazyQuotes
+				cr.TrimLeadingSpace = opts.TrimLeadingSpace
+			}
+			for {
+				rec, err := cr.Read()
+				if err == io.EOF {
+					return
+				}
+				if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+					yield(nil, err)
+					return
+				}
+				if !yield(rec, err) {
+					return
+				}
+			}
+		},
+		nil
+}
+
+// JSONStream returns a sequence2 of values decoded from 'r' and decoding errors.
+// 'r' may contain either a top-level JSON array (its elements are decoded, see [JSONArray])
+// or a stream of JSON values (e.g. [NDJSON], see [JSONValues]).
+// The format is determined by the first non-white-space byte of 'r': '[' means a top-level array,
+// so a stream of JSON arrays must be read with [JSONValues].
+// 'r' is read lazily as the sequence2 is enumerated, so the sequence2 can be enumerated only once.
+// If decoding fails, the error is yielded with [zero value] and the enumeration is stopped.
+//
+// [NDJSON]: https://github.com/ndjson/ndjson-spec
+// [zero value]: https://go.dev/ref/spec#The_zero_value
+func JSONStream[T any](r io.Reader) (iter.Seq2[T, error], error) {
+	if r == nil {
+		return nil, newOperatorError("JSONStream", "r", ErrNilSource)
+	}
+	return func(yield func(T, error) bool) {
+			var t0 T
+			br := bufio.NewReader(r)
+			isArray, err := startsWithArray(br)
+			if err != nil {
+				if err != io.EOF {
+					yield(t0, err)
+				}
+				return
+			}
+			if isArray {
+				decodeArray(json.NewDecoder(br), yield)
+				return
+			}
+			decodeValues(json.NewDecoder(br), yield)
+		},
+		nil
+}
+
+// JSONValues returns a sequence2 of JSON values decoded from 'r' and decoding errors.
+// 'r' must contain a stream of JSON values (e.g. [NDJSON]); each value is decoded as a whole,
+// even if it is a JSON array.
+// 'r' is read lazily as the sequence2 is enumerated, so the sequence2 can be enumerated only once.
+// If decoding fails, the error is yielded with [zero value] and the enumeration is stopped.
+//
+// [NDJSON]: https://github.com/ndjson/ndjson-spec
+// [zero value]: https://go.dev/ref/spec#The_zero_value
+func JSONValues[T any](r io.Reader) (iter.Seq2[T, error], error) {
+	if r == nil {
+		return nil, newOperatorError("JSONValues", "r", ErrNilSource)
+	}
+	return func(yield func(T, error) bool) {
+			decodeValues(json.NewDecoder(r), yield)
+		},
+		nil
+}
+
+// JSONArray returns a sequence2 of the elements of a top-level JSON array decoded from 'r' and decoding errors.
+// Empty 'r' (or 'r' containing only white space) produces an empty sequence2.
+// If 'r' contains something other than a JSON array, [ErrNotJSONArray] is yielded.
+// 'r' is read lazily as the sequence2 is enumerated, so the sequence2 can be enumerated only once.
+// If decoding fails, the error is yielded with [zero value] and the enumeration is stopped.
+//
+// [zero value]: https://go.dev/ref/spec#The_zero_value
+func JSONArray[T any](r io.Reader) (iter.Seq2[T, error], error) {
+	if r == nil {
+		return nil, newOperatorError("JSONArray", "r", ErrNilSource)
+	}
+	return func(yield func(T, error) bool) {
+			decodeArray(json.NewDecoder(r), yield)
+		},
+		nil
+}
+
+// decodeValues yields values decoded from 'dec' until the end of input or the first error.
+func decodeValues[T any](dec *json.Decoder, yield func(T, error) bool) {
+	var t0 T
+	for {
+		var t T
+		err := dec.Decode(&t)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			yield(t0, err)
+			return
+		}
+		if !yield(t, nil) {
+			return
+		}
+	}
+}
+
+// decodeArray yields the elements of a JSON array decoded from 'dec' until the end of the array or the first error.
+func decodeArray[T any](dec *json.Decoder, yield func(T, error) bool) {
+	var t0 T
+	// consume '['
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return
+	}
+	if err != nil {
+		yield(t0, err)
+		return
+	}
+	if tok != json.Delim('[') {
+		yield(t0, ErrNotJSONArray)
+		return
+	}
+	for dec.More() {
+		var t T
+		if err := dec.Decode(&t); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			yield(t0, err)
+			return
+		}
+		if !yield(t, nil) {
+			return
+		}
+	}
+	// consume ']'
+	if _, err := dec.Token(); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		yield(t0, err)
+	}
+}
+
+// startsWithArray skips leading white space in 'br' and determines whether the next byte is '['.
+func startsWithArray(br *bufio.Reader) (bool, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return false, err
+		}
+		if bytes.IndexByte([]byte(" \t\r\n"), b) >= 0 {
+			continue
+		}
+		return b == '[', br.UnreadByte()
+	}
+}
@@ -0,0 +1,300 @@
+package go2linq
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// collect2 returns contents of the sequence2 and the first error.
+func collect2[T any](seq2 iter.Seq2[T, error]) ([]T, error) {
+	var tt []T
+	for t, err := range seq2 {
+		if err != nil {
+			return tt, err
+		}
+		tt = append(tt, t)
+	}
+	return tt, nil
+}
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       io.Reader
+		want    []string
+		wantErr error
+	}{
+		{name: "Empty",
+			r: strings.NewReader(""),
+		},
+		{name: "Lines",
+			r:    strings.NewReader("one\ntwo\r\n\nthree"),
+			want: []string{"one", "two", "", "three"},
+		},
+		{name: "ReadError",
+			r:       io.MultiReader(strings.NewReader("one\ntwo\n"), iotest.ErrReader(ErrTestError)),
+			want:    []string{"one", "two"},
+			wantErr: ErrTestError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, _ := Lines(tt.r)
+			got, err := collect2(lines)
+			if err != tt.wantErr {
+				t.Errorf("Lines() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			equal, _ := SequenceEqual(SliceAll(got), SliceAll(tt.want))
+			if !equal {
+				t.Errorf("Lines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	if _, err := Split(nil, bufio.ScanWords); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Split() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := Split(strings.NewReader(""), nil); !errors.Is(err, ErrNilSplit) {
+		t.Errorf("Split() error = %v, expectedErr %v", err, ErrNilSplit)
+	}
+	split, _ := Split(strings.NewReader(" the quick\tbrown\n\nfox "), bufio.ScanWords)
+	got, _ := collect2(split)
+	want := []string{"the", "quick", "brown", "fox"}
+	equal, _ := SequenceEqual(SliceAll(got), SliceAll(want))
+	if !equal {
+		t.Errorf("Split() = %q, want %q", got, want)
+	}
+}
+
+func TestSplit_streaming(t *testing.T) {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("first\n"))
+		// the rest is never written, so the reader would block if the whole input were read
+	}()
+	lines, _ := Lines(pr)
+	for line, err := range lines {
+		if err != nil || line != "first" {
+			t.Errorf("Lines() = %q, %v, want \"first\", nil", line, err)
+		}
+		break
+	}
+	pw.Close()
+}
+
+func TestCSVRecords(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    *CSVOptions
+		want    [][]string
+		wantErr error
+	}{
+		{name: "Default",
+			input: "name,age\n\"Smith, John\",42\n",
+			want:  [][]string{{"name", "age"}, {"Smith, John", "42"}},
+		},
+		{name: "Options",
+			input: "# comment\na; b\nc; d\n",
+			opts:  &CSVOptions{Comma: ';', Comment: '#', TrimLeadingSpace: true},
+			want:  [][]string{{"a", "b"}, {"c", "d"}},
+		},
+		{name: "FieldCount",
+			input:   "a,b\nc\n",
+			want:    [][]string{{"a", "b"}},
+			wantErr: csv.ErrFieldCount,
+		},
+		{name: "Quote",
+			input:   "a,b\nc,\"d\n",
+			want:    [][]string{{"a", "b"}},
+			wantErr: csv.ErrQuote,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, _ := CSVRecords(strings.NewReader(tt.input), tt.opts)
+			got, err := collect2(records)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CSVRecords() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			equal, _ := SequenceEqual(SliceAll(got), SliceAll(tt.want))
+			if !equal {
+				t.Errorf("CSVRecords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type jsonItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestJSONStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []jsonItem
+		wantErr bool
+	}{
+		{name: "Empty",
+			input: "  ",
+		},
+		{name: "NDJSON",
+			input: "{\"id\":1,\"name\":\"a\"}\n{\"id\":2,\"name\":\"b\"}\n",
+			want:  []jsonItem{{1, "a"}, {2, "b"}},
+		},
+		{name: "BadNDJSON",
+			input:   "{\"id\":1,\"name\":\"a\"}\n{\"id\":\"x\"}\n",
+			want:    []jsonItem{{1, "a"}},
+			wantErr: true,
+		},
+		{name: "Array",
+			input: " [ {\"id\":1,\"name\":\"a\"}, {\"id\":2,\"name\":\"b\"} ] ",
+			want:  []jsonItem{{1, "a"}, {2, "b"}},
+		},
+		{name: "EmptyArray",
+			input: "[]",
+		},
+		{name: "TruncatedArray",
+			input:   "[{\"id\":1,\"name\":\"a\"},",
+			want:    []jsonItem{{1, "a"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, _ := JSONStream[jsonItem](strings.NewReader(tt.input))
+			got, err := collect2(stream)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JSONStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			equal, _ := SequenceEqual(SliceAll(got), SliceAll(tt.want))
+			if !equal {
+				t.Errorf("JSONStream() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONValues(t *testing.T) {
+	stream, _ := JSONValues[[]int](strings.NewReader("[1,2]\n[3]\n[]\n"))
+	got, err := collect2(stream)
+	if err != nil {
+		t.Fatalf("JSONValues() error = %v", err)
+	}
+	if s := fmt.Sprint(got); s != "[[1 2] [3] []]" {
+		t.Errorf("JSONValues() = %v, want [[1 2] [3] []]", s)
+	}
+	stream, _ = JSONValues[[]int](strings.NewReader("[1]\n{}"))
+	if _, err := collect2(stream); err == nil {
+		t.Errorf("JSONValues() error = nil, want error")
+	}
+}
+
+func TestJSONArray(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		want        []jsonItem
+		wantErr     bool
+		expectedErr error
+	}{
+		{name: "Empty",
+			input: "  ",
+		},
+		{name: "Array",
+			input: " [ {\"id\":1,\"name\":\"a\"}, {\"id\":2,\"name\":\"b\"} ] ",
+			want:  []jsonItem{{1, "a"}, {2, "b"}},
+		},
+		{name: "EmptyArray",
+			input: "[]",
+		},
+		{name: "TruncatedArray",
+			input:   "[{\"id\":1,\"name\":\"a\"},",
+			want:    []jsonItem{{1, "a"}},
+			wantErr: true,
+		},
+		{name: "NDJSON",
+			input:       "{\"id\":1,\"name\":\"a\"}\n",
+			wantErr:     true,
+			expectedErr: ErrNotJSONArray,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, _ := JSONArray[jsonItem](strings.NewReader(tt.input))
+			got, err := collect2(stream)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("JSONArray() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("JSONArray() error = %v, expectedErr %v", err, tt.expectedErr)
+			}
+			equal, _ := SequenceEqual(SliceAll(got), SliceAll(tt.want))
+			if !equal {
+				t.Errorf("JSONArray() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleLines() {
+	lines, _ := Lines(strings.NewReader("alpha\nbeta\ngamma\n"))
+	for line, err := range lines {
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(strings.ToUpper(line))
+	}
+	// Output:
+	// ALPHA
+	// BETA
+	// GAMMA
+}
+
+func ExampleJSONStream() {
+	type Pet struct {
+		Name string
+		Age  int
+	}
+	stream, _ := JSONStream[Pet](strings.NewReader("{\"Name\":\"Barley\",\"Age\":8}\n{\"Name\":\"Boots\",\"Age\":4}\n"))
+	for pet, err := range stream {
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%s is %d\n", pet.Name, pet.Age)
+	}
+	// Output:
+	// Barley is 8
+	// Boots is 4
+}
+
+func ExampleJSONArray() {
+	type Pet struct {
+		Name string
+		Age  int
+	}
+	array, _ := JSONArray[Pet](strings.NewReader(`[{"Name":"Barley","Age":8},{"Name":"Boots","Age":4}]`))
+	for pet, err := range array {
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%s is %d\n", pet.Name, pet.Age)
+	}
+	// Output:
+	// Barley is 8
+	// Boots is 4
+}
@@ -9,7 +9,7 @@ import (
 // [Repeat]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.repeat
 func Repeat[Result any](element Result, count int) (iter.Seq[Result], error) {
 	if count < 0 {
-		return nil, ErrNegativeCount
+		return nil, newOperatorError("Repeat", "", ErrNegativeCount)
 	}
 	return func(yield func(Result) bool) {
 			for range count {
@@ -20,3 +20,12 @@ func Repeat[Result any](element Result, count int) (iter.Seq[Result], error) {
 		},
 		nil
 }
+
+// RepeatForever generates an infinite sequence that contains one repeated value.
+// Use operators like [Take] or [TakeWhile] to limit the sequence.
+func RepeatForever[Result any](element Result) iter.Seq[Result] {
+	return func(yield func(Result) bool) {
+		for yield(element) {
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"testing"
@@ -51,7 +52,7 @@ func TestRepeat_string(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("Repeat() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -118,3 +119,14 @@ func ExampleRepeat() {
 	// I like programming.
 	// I like programming.
 }
+
+func TestRepeatForever(t *testing.T) {
+	got, _ := Take(RepeatForever("x"), 3)
+	if s := StringDef(got); s != "[x x x]" {
+		t.Errorf("RepeatForever() = %v, want [x x x]", s)
+	}
+	got, _ = TakeWhile(RepeatForever("x"), func(string) bool { return false })
+	if s := StringDef(got); s != "[]" {
+		t.Errorf("RepeatForever() = %v, want []", s)
+	}
+}
@@ -9,7 +9,7 @@ import (
 // [Reverse]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.reverse
 func Reverse[Source any](source iter.Seq[Source]) (iter.Seq[Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("Reverse", "source", ErrNilSource)
 	}
 	return func(yield func(Source) bool) {
 			ss, _ := ToSlice(source)
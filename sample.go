@@ -0,0 +1,135 @@
+package go2linq
+
+import (
+	"container/heap"
+	"iter"
+	"math"
+	"math/rand/v2"
+)
+
+// Sample returns 'k' elements chosen uniformly at random from a sequence
+// (or all elements if the sequence contains fewer than 'k' elements).
+// Sample uses reservoir sampling, so 'source' is enumerated once and may be of arbitrary length.
+// The order of the chosen elements is unspecified.
+// Random numbers are taken from 'rng'. If 'rng' is nil, the top-level functions of [math/rand/v2] are used.
+// 'source' is enumerated when the result is enumerated.
+func Sample[Source any](source iter.Seq[Source], k int, rng *rand.Rand) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("Sample", "source", ErrNilSource)
+	}
+	if k < 0 {
+		return nil, newOperatorError("Sample", "k", ErrNegativeCount)
+	}
+	return func(yield func(Source) bool) {
+			if k == 0 {
+				return
+			}
+			reservoir := make([]Source, 0, min(k, maxPagePrealloc))
+			i := 0
+			for s := range source {
+				if i < k {
+					reservoir = append(reservoir, s)
+				} else if j := randIntN(rng, i+1); j < k {
+					reservoir[j] = s
+				}
+				i++
+			}
+			for _, s := range reservoir {
+				if !yield(s) {
+					return
+				}
+			}
+		},
+		nil
+}
+
+// weightedItem is an element with its sampling key.
+type weightedItem[Source any] struct {
+	s   Source
+	key float64
+}
+
+// weightedHeap is a min-heap of weighted items by key.
+type weightedHeap[Source any] []weightedItem[Source]
+
+func (h weightedHeap[Source]) Len() int           { return len(h) }
+func (h weightedHeap[Source]) Less(i, j int) bool { return h[i].key < h[j].key }
+func (h weightedHeap[Source]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *weightedHeap[Source]) Push(x any)        { *h = append(*h, x.(weightedItem[Source])) }
+func (h *weightedHeap[Source]) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// WeightedSample returns (without replacement) 'k' elements chosen at random from a sequence,
+// each element being chosen with probability proportional to its weight returned by 'weightSelector'.
+// Elements with non-positive or NaN weight are never chosen.
+// WeightedSample uses the Efraimidis–Spirakis reservoir algorithm,
+// so 'source' is enumerated once and may be of arbitrary length.
+// The chosen elements are returned in descending order of their sampling keys.
+// Random numbers are taken from 'rng'. If 'rng' is nil, the top-level functions of [math/rand/v2] are used.
+// 'source' is enumerated when the result is enumerated.
+func WeightedSample[Source any](source iter.Seq[Source], k int,
+	weightSelector func(Source) float64, rng *rand.Rand) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("WeightedSample", "source", ErrNilSource)
+	}
+	if k < 0 {
+		return nil, newOperatorError("WeightedSample", "k", ErrNegativeCount)
+	}
+	if weightSelector == nil {
+		return nil, newOperatorError("WeightedSample", "weightSelector", ErrNilSelector)
+	}
+	return func(yield func(Source) bool) {
+			if k == 0 {
+				return
+			}
+			h := make(weightedHeap[Source], 0, min(k, maxPagePrealloc))
+			for s := range source {
+				w := weightSelector(s)
+				if !(w > 0) {
+					continue
+				}
+				// key is log(u^(1/w)); 1-Float64() is in (0, 1], so the logarithm is finite
+				key := math.Log(1-randFloat64(rng)) / w
+				if len(h) < k {
+					heap.Push(&h, weightedItem[Source]{s: s, key: key})
+				} else if key > h[0].key {
+					h[0] = weightedItem[Source]{s: s, key: key}
+					heap.Fix(&h, 0)
+				}
+			}
+			chosen := make([]Source, len(h))
+			for i := len(chosen) - 1; i >= 0; i-- {
+				chosen[i] = heap.Pop(&h).(weightedItem[Source]).s
+			}
+			for _, s := range chosen {
+				if !yield(s) {
+					return
+				}
+			}
+		},
+		nil
+}
+
+// RandomElement returns an element chosen uniformly at random from a sequence.
+// 'source' is enumerated once, so it may be of arbitrary length.
+// Random numbers are taken from 'rng'. If 'rng' is nil, the top-level functions of [math/rand/v2] are used.
+func RandomElement[Source any](source iter.Seq[Source], rng *rand.Rand) (Source, error) {
+	var r Source
+	if source == nil {
+		return r, newOperatorError("RandomElement", "source", ErrNilSource)
+	}
+	i := 0
+	for s := range source {
+		if i++; randIntN(rng, i) == 0 {
+			r = s
+		}
+	}
+	if i == 0 {
+		return r, newOperatorError("RandomElement", "", ErrEmptySource)
+	}
+	return r, nil
+}
@@ -0,0 +1,136 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSample(t *testing.T) {
+	if _, err := Sample[int](nil, 1, nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Sample() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := Sample(VarAll(1), -1, nil); !errors.Is(err, ErrNegativeCount) {
+		t.Errorf("Sample() error = %v, expectedErr %v", err, ErrNegativeCount)
+	}
+	tests := []struct {
+		name      string
+		count, k  int
+		wantCount int
+	}{
+		{name: "Zero", count: 10, k: 0, wantCount: 0},
+		{name: "Fewer", count: 3, k: 5, wantCount: 3},
+		{name: "Equal", count: 5, k: 5, wantCount: 5},
+		{name: "More", count: 1000, k: 7, wantCount: 7},
+		{name: "HugeK", count: 3, k: math.MaxInt, wantCount: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := Sample(RangeMust(0, tt.count), tt.k, rand.New(rand.NewPCG(1, 2)))
+			s := ToSliceMust(got)
+			if len(s) != tt.wantCount {
+				t.Fatalf("Sample() = %v, want %d elements", s, tt.wantCount)
+			}
+			slices.Sort(s)
+			if len(slices.Compact(slices.Clone(s))) != len(s) || (len(s) > 0 && (s[0] < 0 || s[len(s)-1] >= tt.count)) {
+				t.Errorf("Sample() = %v, want distinct elements of the source", s)
+			}
+		})
+	}
+}
+
+func TestSample_uniform(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	const n = 20000
+	counts := make([]int, 10)
+	for range n {
+		got, _ := Sample(RangeMust(0, 10), 3, rng)
+		for i := range got {
+			counts[i]++
+		}
+	}
+	// each element is chosen with probability 3/10
+	for i, c := range counts {
+		if c < n*3/10*9/10 || c > n*3/10*11/10 {
+			t.Errorf("element %d was chosen %d times out of %d", i, c, n)
+		}
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	weight := func(f float64) float64 { return f }
+	if _, err := WeightedSample[float64](nil, 1, weight, nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("WeightedSample() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := WeightedSample(VarAll(1.0), -1, weight, nil); !errors.Is(err, ErrNegativeCount) {
+		t.Errorf("WeightedSample() error = %v, expectedErr %v", err, ErrNegativeCount)
+	}
+	if _, err := WeightedSample(VarAll(1.0), 1, nil, nil); !errors.Is(err, ErrNilSelector) {
+		t.Errorf("WeightedSample() error = %v, expectedErr %v", err, ErrNilSelector)
+	}
+	got, _ := WeightedSample(VarAll(0.0, -1, 2, 3), 4, weight, rand.New(rand.NewPCG(1, 2)))
+	s := ToSliceMust(got)
+	slices.Sort(s)
+	if !slices.Equal(s, []float64{2, 3}) {
+		t.Errorf("WeightedSample() = %v, want elements with positive weights [2 3]", s)
+	}
+	got, _ = WeightedSample(VarAll(1.0, 2, 3), math.MaxInt, weight, nil)
+	if s := ToSliceMust(got); len(s) != 3 {
+		t.Errorf("WeightedSample() with huge 'k' = %v, want 3 elements", s)
+	}
+	rng := rand.New(rand.NewPCG(7, 8))
+	const n = 20000
+	counts := make(map[string]int)
+	for range n {
+		got, _ := WeightedSample(VarAll("a", "b", "c"), 1, func(s string) float64 {
+			return map[string]float64{"a": 1, "b": 3, "c": 6}[s]
+		}, rng)
+		for s := range got {
+			counts[s]++
+		}
+	}
+	for s, want := range map[string]int{"a": n / 10, "b": n * 3 / 10, "c": n * 6 / 10} {
+		if c := counts[s]; c < want*9/10 || c > want*11/10 {
+			t.Errorf("%s was chosen %d times, want about %d", s, c, want)
+		}
+	}
+}
+
+func TestRandomElement(t *testing.T) {
+	if _, err := RandomElement[int](nil, nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("RandomElement() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := RandomElement(Empty[int](), nil); !errors.Is(err, ErrEmptySource) {
+		t.Errorf("RandomElement() error = %v, expectedErr %v", err, ErrEmptySource)
+	}
+	rng := rand.New(rand.NewPCG(9, 10))
+	const n = 20000
+	counts := make([]int, 4)
+	for range n {
+		r, _ := RandomElement(RangeMust(0, 4), rng)
+		counts[r]++
+	}
+	for i, c := range counts {
+		if c < n/4*9/10 || c > n/4*11/10 {
+			t.Errorf("element %d was chosen %d times out of %d", i, c, n)
+		}
+	}
+}
+
+func ExampleSample() {
+	users := RangeMust(1, 1_000_000)
+	group, _ := Sample(users, 5, rand.New(rand.NewPCG(42, 42)))
+	fmt.Println(len(ToSliceMust(group)))
+	// Output:
+	// 5
+}
+
+func ExampleRandomElement() {
+	r, _ := RandomElement(VarAll("rock", "paper", "scissors"), rand.New(rand.NewPCG(1, 1)))
+	fmt.Println(r == "rock" || r == "paper" || r == "scissors")
+	// Output:
+	// true
+}
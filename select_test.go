@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"testing"
@@ -83,7 +84,7 @@ func TestSelect_int_int(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("Select() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -210,7 +211,7 @@ func TestSelectIdx_int_int(t *testing.T) {
 				return
 			}
 			if tt.wantErr {
-				if err != tt.expectedErr {
+				if !errors.Is(err, tt.expectedErr) {
 					t.Errorf("SelectIdx() error = %v, expectedErr %v", err, tt.expectedErr)
 				}
 				return
@@ -10,10 +10,10 @@ import (
 // [SelectMany]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.selectmany
 func SelectMany[Source, Result any](source iter.Seq[Source], selector func(Source) iter.Seq[Result]) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("SelectMany", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("SelectMany", "selector", ErrNilSelector)
 	}
 	return func(yield func(Result) bool) {
 			for s := range source {
@@ -33,10 +33,10 @@ func SelectMany[Source, Result any](source iter.Seq[Source], selector func(Sourc
 // [SelectManyIdx]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.selectmany
 func SelectManyIdx[Source, Result any](source iter.Seq[Source], selector func(Source, int) iter.Seq[Result]) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("SelectManyIdx", "source", ErrNilSource)
 	}
 	if selector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("SelectManyIdx", "selector", ErrNilSelector)
 	}
 	return func(yield func(Result) bool) {
 			i := 0
@@ -60,10 +60,13 @@ func SelectManyIdx[Source, Result any](source iter.Seq[Source], selector func(So
 func SelectManyColl[Source, Collection, Result any](source iter.Seq[Source],
 	collectionSelector func(Source) iter.Seq[Collection], resultSelector func(Source, Collection) Result) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("SelectManyColl", "source", ErrNilSource)
 	}
-	if collectionSelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if collectionSelector == nil {
+		return nil, newOperatorError("SelectManyColl", "collectionSelector", ErrNilSelector)
+	}
+	if resultSelector == nil {
+		return nil, newOperatorError("SelectManyColl", "resultSelector", ErrNilSelector)
 	}
 	return func(yield func(Result) bool) {
 			for s := range source {
@@ -85,10 +88,13 @@ func SelectManyColl[Source, Collection, Result any](source iter.Seq[Source],
 func SelectManyCollIdx[Source, Collection, Result any](source iter.Seq[Source],
 	collectionSelector func(Source, int) iter.Seq[Collection], resultSelector func(Source, Collection) Result) (iter.Seq[Result], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("SelectManyCollIdx", "source", ErrNilSource)
+	}
+	if collectionSelector == nil {
+		return nil, newOperatorError("SelectManyCollIdx", "collectionSelector", ErrNilSelector)
 	}
-	if collectionSelector == nil || resultSelector == nil {
-		return nil, ErrNilSelector
+	if resultSelector == nil {
+		return nil, newOperatorError("SelectManyCollIdx", "resultSelector", ErrNilSelector)
 	}
 	return func(yield func(Result) bool) {
 			i := 0
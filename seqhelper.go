@@ -73,10 +73,10 @@ func StringDef2[K, V any](seq2 iter.Seq2[K, V]) string {
 // operation is stopped and corresponding error is returned.
 func ForEach[T any](ctx context.Context, seq iter.Seq[T], action func(T) error) error {
 	if seq == nil {
-		return ErrNilSource
+		return newOperatorError("ForEach", "seq", ErrNilSource)
 	}
 	if action == nil {
-		return ErrNilAction
+		return newOperatorError("ForEach", "action", ErrNilAction)
 	}
 	for t := range seq {
 		select {
@@ -96,10 +96,10 @@ func ForEach[T any](ctx context.Context, seq iter.Seq[T], action func(T) error)
 // operation is stopped and corresponding error is returned.
 func ForEachConcurrent[T any](ctx context.Context, seq iter.Seq[T], action func(T) error) error {
 	if seq == nil {
-		return ErrNilSource
+		return newOperatorError("ForEachConcurrent", "seq", ErrNilSource)
 	}
 	if action == nil {
-		return ErrNilAction
+		return newOperatorError("ForEachConcurrent", "action", ErrNilAction)
 	}
 	g := new(errgroup.Group)
 	for t := range seq {
@@ -120,18 +120,17 @@ func ForEachConcurrent[T any](ctx context.Context, seq iter.Seq[T], action func(
 
 // SeqString converts a sequence to a sequence of strings.
 func SeqString[T any](seq iter.Seq[T]) (iter.Seq[string], error) {
+	if seq == nil {
+		return nil, newOperatorError("SeqString", "seq", ErrNilSource)
+	}
 	return Select[T, string](seq, func(t T) string { return fmt.Sprint(t) })
 }
 
 // Strings returns a sequence contents as a slice of strings.
 func Strings[T any](seq iter.Seq[T]) ([]string, error) {
-	seqString, err := SeqString[T](seq)
-	if err != nil {
-		return nil, err
-	}
-	ss, err := ToSlice(seqString)
-	if err != nil {
-		return nil, err
+	if seq == nil {
+		return nil, newOperatorError("Strings", "seq", ErrNilSource)
 	}
-	return ss, nil
+	seqString, _ := SeqString[T](seq)
+	return ToSlice(seqString)
 }
@@ -0,0 +1,93 @@
+package go2linq
+
+import (
+	"iter"
+)
+
+// SequenceCompare compares two sequences lexicographically using a specified 'compare' to compare the elements.
+// Like [slices.Compare], the result is -1, 0 or +1: the sign of 'compare' for the first pair of unequal elements.
+// If one sequence is a prefix of the other, the shorter sequence is less.
+// SequenceCompare returns 0 if the sequences are equal, -1 if 'first' is less and +1 if 'first' is greater.
+func SequenceCompare[Source any](first, second iter.Seq[Source], compare func(Source, Source) int) (int, error) {
+	if first == nil {
+		return 0, newOperatorError("SequenceCompare", "first", ErrNilSource)
+	}
+	if second == nil {
+		return 0, newOperatorError("SequenceCompare", "second", ErrNilSource)
+	}
+	if compare == nil {
+		return 0, newOperatorError("SequenceCompare", "compare", ErrNilCompare)
+	}
+	next1, stop1 := iter.Pull(first)
+	defer stop1()
+	next2, stop2 := iter.Pull(second)
+	defer stop2()
+	for {
+		s1, ok1 := next1()
+		s2, ok2 := next2()
+		switch {
+		case !ok1 && !ok2:
+			return 0, nil
+		case !ok1:
+			return -1, nil
+		case !ok2:
+			return +1, nil
+		}
+		if c := compare(s1, s2); c != 0 {
+			return sign(c), nil
+		}
+	}
+}
+
+// SequenceCompare2 compares two sequence2s lexicographically using specified compares.
+// Pairs are compared by keys first, then by values.
+// Like [slices.Compare], the result is -1, 0 or +1 regardless of the magnitudes returned by the compares.
+// If one sequence2 is a prefix of the other, the shorter sequence2 is less.
+// SequenceCompare2 returns 0 if the sequence2s are equal, -1 if 'first' is less and +1 if 'first' is greater.
+func SequenceCompare2[K, V any](first, second iter.Seq2[K, V], compareK func(K, K) int, compareV func(V, V) int) (int, error) {
+	if first == nil {
+		return 0, newOperatorError("SequenceCompare2", "first", ErrNilSource)
+	}
+	if second == nil {
+		return 0, newOperatorError("SequenceCompare2", "second", ErrNilSource)
+	}
+	if compareK == nil {
+		return 0, newOperatorError("SequenceCompare2", "compareK", ErrNilCompare)
+	}
+	if compareV == nil {
+		return 0, newOperatorError("SequenceCompare2", "compareV", ErrNilCompare)
+	}
+	next1, stop1 := iter.Pull2(first)
+	defer stop1()
+	next2, stop2 := iter.Pull2(second)
+	defer stop2()
+	for {
+		k1, v1, ok1 := next1()
+		k2, v2, ok2 := next2()
+		switch {
+		case !ok1 && !ok2:
+			return 0, nil
+		case !ok1:
+			return -1, nil
+		case !ok2:
+			return +1, nil
+		}
+		if c := compareK(k1, k2); c != 0 {
+			return sign(c), nil
+		}
+		if c := compareV(v1, v2); c != 0 {
+			return sign(c), nil
+		}
+	}
+}
+
+// sign returns -1, 0 or +1 depending on the sign of 'c'.
+func sign(c int) int {
+	switch {
+	case c < 0:
+		return -1
+	case c > 0:
+		return +1
+	}
+	return 0
+}
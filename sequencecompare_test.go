@@ -0,0 +1,84 @@
+package go2linq
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSequenceCompare(t *testing.T) {
+	if _, err := SequenceCompare(nil, VarAll(1), cmp.Compare[int]); !errors.Is(err, ErrNilSource) {
+		t.Errorf("SequenceCompare() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := SequenceCompare(VarAll(1), nil, cmp.Compare[int]); !errors.Is(err, ErrNilSource) {
+		t.Errorf("SequenceCompare() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := SequenceCompare(VarAll(1), VarAll(1), nil); !errors.Is(err, ErrNilCompare) {
+		t.Errorf("SequenceCompare() error = %v, expectedErr %v", err, ErrNilCompare)
+	}
+	tests := []struct {
+		name          string
+		first, second iter.Seq[int]
+		want          int
+	}{
+		{name: "BothEmpty", first: Empty[int](), second: Empty[int](), want: 0},
+		{name: "Equal", first: VarAll(1, 2, 3), second: VarAll(1, 2, 3), want: 0},
+		{name: "Less", first: VarAll(1, 2, 3), second: VarAll(1, 3), want: -1},
+		{name: "Greater", first: VarAll(2), second: VarAll(1, 9, 9), want: +1},
+		{name: "Prefix", first: VarAll(1, 2), second: VarAll(1, 2, 0), want: -1},
+		{name: "Longer", first: VarAll(1, 2, 0), second: VarAll(1, 2), want: +1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := SequenceCompare(tt.first, tt.second, func(x, y int) int { return 10 * cmp.Compare(x, y) })
+			if got != tt.want {
+				t.Errorf("SequenceCompare() = %v, want %v", got, tt.want)
+			}
+			// must agree with slices.Compare
+			if want := slices.Compare(ToSliceMust(tt.first), ToSliceMust(tt.second)); got != want {
+				t.Errorf("SequenceCompare() = %v, slices.Compare() = %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSequenceCompare2(t *testing.T) {
+	if _, err := SequenceCompare2(SliceAll2([]int{}), SliceAll2([]int{}), cmp.Compare[int], nil); !errors.Is(err, ErrNilCompare) {
+		t.Errorf("SequenceCompare2() error = %v, expectedErr %v", err, ErrNilCompare)
+	}
+	pair := func(k string, v int) iter.Seq2[string, int] {
+		return func(yield func(string, int) bool) { yield(k, v) }
+	}
+	tests := []struct {
+		name          string
+		first, second iter.Seq2[string, int]
+		want          int
+	}{
+		{name: "Equal", first: pair("a", 1), second: pair("a", 1), want: 0},
+		{name: "KeyLess", first: pair("a", 9), second: pair("b", 1), want: -1},
+		{name: "ValueGreater", first: pair("a", 2), second: pair("a", 1), want: +1},
+		{name: "Shorter", first: Empty2[string, int](), second: pair("a", 1), want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := SequenceCompare2(tt.first, tt.second, strings.Compare, cmp.Compare[int]); got != tt.want {
+				t.Errorf("SequenceCompare2() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleSequenceCompare() {
+	versions := [][]int{{1, 10, 0}, {1, 2}, {1, 2, 1}, {0, 9}}
+	slices.SortFunc(versions, func(v1, v2 []int) int {
+		c, _ := SequenceCompare(SliceAll(v1), SliceAll(v2), cmp.Compare[int])
+		return c
+	})
+	fmt.Println(versions)
+	// Output:
+	// [[0 9] [1 2] [1 2 1] [1 10 0]]
+}
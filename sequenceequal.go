@@ -10,8 +10,11 @@ import (
 //
 // [SequenceEqual]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.sequenceequal
 func SequenceEqual[Source any](first, second iter.Seq[Source]) (bool, error) {
-	if first == nil || second == nil {
-		return false, ErrNilSource
+	if first == nil {
+		return false, newOperatorError("SequenceEqual", "first", ErrNilSource)
+	}
+	if second == nil {
+		return false, newOperatorError("SequenceEqual", "second", ErrNilSource)
 	}
 	return SequenceEqualEq(first, second, generichelper.DeepEqual[Source])
 }
@@ -20,11 +23,14 @@ func SequenceEqual[Source any](first, second iter.Seq[Source]) (bool, error) {
 //
 // [SequenceEqualEq]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.sequenceequal
 func SequenceEqualEq[Source any](first, second iter.Seq[Source], equal func(Source, Source) bool) (bool, error) {
-	if first == nil || second == nil {
-		return false, ErrNilSource
+	if first == nil {
+		return false, newOperatorError("SequenceEqualEq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return false, newOperatorError("SequenceEqualEq", "second", ErrNilSource)
 	}
 	if equal == nil {
-		return false, ErrNilEqual
+		return false, newOperatorError("SequenceEqualEq", "equal", ErrNilEqual)
 	}
 	next1, stop1 := iter.Pull(first)
 	defer stop1()
@@ -49,19 +55,28 @@ func SequenceEqualEq[Source any](first, second iter.Seq[Source], equal func(Sour
 
 // SequenceEqual2 determines whether two sequence2s are equal by comparing the elements using [generichelper.DeepEqual].
 func SequenceEqual2[K, V any](first, second iter.Seq2[K, V]) (bool, error) {
-	if first == nil || second == nil {
-		return false, ErrNilSource
+	if first == nil {
+		return false, newOperatorError("SequenceEqual2", "first", ErrNilSource)
+	}
+	if second == nil {
+		return false, newOperatorError("SequenceEqual2", "second", ErrNilSource)
 	}
 	return SequenceEqual2Eq(first, second, generichelper.DeepEqual[K], generichelper.DeepEqual[V])
 }
 
 // SequenceEqual2Eq determines whether two sequence2s are equal by comparing their elements using specified equals.
 func SequenceEqual2Eq[K, V any](first, second iter.Seq2[K, V], equalK func(K, K) bool, equalV func(V, V) bool) (bool, error) {
-	if first == nil || second == nil {
-		return false, ErrNilSource
+	if first == nil {
+		return false, newOperatorError("SequenceEqual2Eq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return false, newOperatorError("SequenceEqual2Eq", "second", ErrNilSource)
+	}
+	if equalK == nil {
+		return false, newOperatorError("SequenceEqual2Eq", "equalK", ErrNilEqual)
 	}
-	if equalK == nil || equalV == nil {
-		return false, ErrNilEqual
+	if equalV == nil {
+		return false, newOperatorError("SequenceEqual2Eq", "equalV", ErrNilEqual)
 	}
 	next1, stop1 := iter.Pull2(first)
 	defer stop1()
@@ -0,0 +1,149 @@
+package go2linq
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/solsw/generichelper"
+)
+
+// Mismatch describes the first position at which two sequences differ.
+type Mismatch[T any] struct {
+	// Index is the zero-based index of the first differing element.
+	Index int
+	// First is the element of the first sequence at Index (zero value if FirstEnded).
+	First T
+	// Second is the element of the second sequence at Index (zero value if SecondEnded).
+	Second T
+	// FirstEnded reports whether the first sequence is shorter and ended at Index.
+	FirstEnded bool
+	// SecondEnded reports whether the second sequence is shorter and ended at Index.
+	SecondEnded bool
+}
+
+// String implements the [fmt.Stringer] interface.
+func (m Mismatch[T]) String() string {
+	switch {
+	case m.FirstEnded:
+		return fmt.Sprintf("index %d: first ended, second %v", m.Index, m.Second)
+	case m.SecondEnded:
+		return fmt.Sprintf("index %d: first %v, second ended", m.Index, m.First)
+	}
+	return fmt.Sprintf("index %d: first %v, second %v", m.Index, m.First, m.Second)
+}
+
+// SequenceMismatch finds the first position at which two sequences differ
+// comparing the elements using [generichelper.DeepEqual].
+// SequenceMismatch returns false if the sequences are equal.
+func SequenceMismatch[Source any](first, second iter.Seq[Source]) (Mismatch[Source], bool, error) {
+	if first == nil {
+		return Mismatch[Source]{}, false, newOperatorError("SequenceMismatch", "first", ErrNilSource)
+	}
+	if second == nil {
+		return Mismatch[Source]{}, false, newOperatorError("SequenceMismatch", "second", ErrNilSource)
+	}
+	return SequenceMismatchEq(first, second, generichelper.DeepEqual[Source])
+}
+
+// SequenceMismatchEq finds the first position at which two sequences differ
+// comparing the elements using a specified 'equal'.
+// SequenceMismatchEq returns false if the sequences are equal.
+func SequenceMismatchEq[Source any](first, second iter.Seq[Source], equal func(Source, Source) bool) (Mismatch[Source], bool, error) {
+	if first == nil {
+		return Mismatch[Source]{}, false, newOperatorError("SequenceMismatchEq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return Mismatch[Source]{}, false, newOperatorError("SequenceMismatchEq", "second", ErrNilSource)
+	}
+	if equal == nil {
+		return Mismatch[Source]{}, false, newOperatorError("SequenceMismatchEq", "equal", ErrNilEqual)
+	}
+	next1, stop1 := iter.Pull(first)
+	defer stop1()
+	next2, stop2 := iter.Pull(second)
+	defer stop2()
+	for i := 0; ; i++ {
+		s1, ok1 := next1()
+		s2, ok2 := next2()
+		if !ok1 && !ok2 {
+			return Mismatch[Source]{}, false, nil
+		}
+		if !ok1 || !ok2 || !equal(s1, s2) {
+			return Mismatch[Source]{Index: i, First: s1, Second: s2, FirstEnded: !ok1, SecondEnded: !ok2}, true, nil
+		}
+	}
+}
+
+// Mismatch2 describes the first position at which two sequence2s differ.
+type Mismatch2[K, V any] struct {
+	// Index is the zero-based index of the first differing pair.
+	Index int
+	// FirstKey and FirstValue are the pair of the first sequence2 at Index (zero values if FirstEnded).
+	FirstKey   K
+	FirstValue V
+	// SecondKey and SecondValue are the pair of the second sequence2 at Index (zero values if SecondEnded).
+	SecondKey   K
+	SecondValue V
+	// FirstEnded reports whether the first sequence2 is shorter and ended at Index.
+	FirstEnded bool
+	// SecondEnded reports whether the second sequence2 is shorter and ended at Index.
+	SecondEnded bool
+}
+
+// String implements the [fmt.Stringer] interface.
+func (m Mismatch2[K, V]) String() string {
+	switch {
+	case m.FirstEnded:
+		return fmt.Sprintf("index %d: first ended, second %v:%v", m.Index, m.SecondKey, m.SecondValue)
+	case m.SecondEnded:
+		return fmt.Sprintf("index %d: first %v:%v, second ended", m.Index, m.FirstKey, m.FirstValue)
+	}
+	return fmt.Sprintf("index %d: first %v:%v, second %v:%v", m.Index, m.FirstKey, m.FirstValue, m.SecondKey, m.SecondValue)
+}
+
+// SequenceMismatch2 finds the first position at which two sequence2s differ
+// comparing the elements using [generichelper.DeepEqual].
+// SequenceMismatch2 returns false if the sequence2s are equal.
+func SequenceMismatch2[K, V any](first, second iter.Seq2[K, V]) (Mismatch2[K, V], bool, error) {
+	if first == nil {
+		return Mismatch2[K, V]{}, false, newOperatorError("SequenceMismatch2", "first", ErrNilSource)
+	}
+	if second == nil {
+		return Mismatch2[K, V]{}, false, newOperatorError("SequenceMismatch2", "second", ErrNilSource)
+	}
+	return SequenceMismatch2Eq(first, second, generichelper.DeepEqual[K], generichelper.DeepEqual[V])
+}
+
+// SequenceMismatch2Eq finds the first position at which two sequence2s differ
+// comparing the elements using specified equals.
+// SequenceMismatch2Eq returns false if the sequence2s are equal.
+func SequenceMismatch2Eq[K, V any](first, second iter.Seq2[K, V],
+	equalK func(K, K) bool, equalV func(V, V) bool) (Mismatch2[K, V], bool, error) {
+	if first == nil {
+		return Mismatch2[K, V]{}, false, newOperatorError("SequenceMismatch2Eq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return Mismatch2[K, V]{}, false, newOperatorError("SequenceMismatch2Eq", "second", ErrNilSource)
+	}
+	if equalK == nil {
+		return Mismatch2[K, V]{}, false, newOperatorError("SequenceMismatch2Eq", "equalK", ErrNilEqual)
+	}
+	if equalV == nil {
+		return Mismatch2[K, V]{}, false, newOperatorError("SequenceMismatch2Eq", "equalV", ErrNilEqual)
+	}
+	next1, stop1 := iter.Pull2(first)
+	defer stop1()
+	next2, stop2 := iter.Pull2(second)
+	defer stop2()
+	for i := 0; ; i++ {
+		k1, v1, ok1 := next1()
+		k2, v2, ok2 := next2()
+		if !ok1 && !ok2 {
+			return Mismatch2[K, V]{}, false, nil
+		}
+		if !ok1 || !ok2 || !(equalK(k1, k2) && equalV(v1, v2)) {
+			return Mismatch2[K, V]{Index: i, FirstKey: k1, FirstValue: v1, SecondKey: k2, SecondValue: v2,
+				FirstEnded: !ok1, SecondEnded: !ok2}, true, nil
+		}
+	}
+}
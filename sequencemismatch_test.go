@@ -0,0 +1,95 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+)
+
+func TestSequenceMismatch(t *testing.T) {
+	if _, _, err := SequenceMismatch(nil, VarAll(1)); !errors.Is(err, ErrNilSource) {
+		t.Errorf("SequenceMismatch() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, _, err := SequenceMismatch(VarAll(1), nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("SequenceMismatch() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	tests := []struct {
+		name          string
+		first, second iter.Seq[int]
+		want          Mismatch[int]
+		wantFound     bool
+	}{
+		{name: "BothEmpty", first: Empty[int](), second: Empty[int]()},
+		{name: "Equal", first: VarAll(1, 2, 3), second: VarAll(1, 2, 3)},
+		{name: "DifferentElement", first: VarAll(1, 2, 3), second: VarAll(1, 5, 3),
+			want: Mismatch[int]{Index: 1, First: 2, Second: 5}, wantFound: true},
+		{name: "FirstShorter", first: VarAll(1, 2), second: VarAll(1, 2, 3),
+			want: Mismatch[int]{Index: 2, Second: 3, FirstEnded: true}, wantFound: true},
+		{name: "SecondShorter", first: VarAll(1, 2, 3), second: VarAll(1),
+			want: Mismatch[int]{Index: 1, First: 2, SecondEnded: true}, wantFound: true},
+		{name: "FirstEmpty", first: Empty[int](), second: VarAll(7),
+			want: Mismatch[int]{Index: 0, Second: 7, FirstEnded: true}, wantFound: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, _ := SequenceMismatch(tt.first, tt.second)
+			if got != tt.want || found != tt.wantFound {
+				t.Errorf("SequenceMismatch() = %+v, %v, want %+v, %v", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestSequenceMismatchEq(t *testing.T) {
+	if _, _, err := SequenceMismatchEq(VarAll(1), VarAll(1), nil); !errors.Is(err, ErrNilEqual) {
+		t.Errorf("SequenceMismatchEq() error = %v, expectedErr %v", err, ErrNilEqual)
+	}
+	got, found, _ := SequenceMismatchEq(VarAll("a", "B", "c"), VarAll("A", "b", "d"), caseInsensitiveEqual)
+	if want := (Mismatch[string]{Index: 2, First: "c", Second: "d"}); !found || got != want {
+		t.Errorf("SequenceMismatchEq() = %+v, %v, want %+v, true", got, found, want)
+	}
+	if s := got.String(); s != "index 2: first c, second d" {
+		t.Errorf("Mismatch.String() = %q", s)
+	}
+}
+
+func TestSequenceMismatch2(t *testing.T) {
+	if _, _, err := SequenceMismatch2[int, string](nil, SliceAll2([]string{})); !errors.Is(err, ErrNilSource) {
+		t.Errorf("SequenceMismatch2() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, _, err := SequenceMismatch2Eq(SliceAll2([]string{}), SliceAll2([]string{}), nil, caseInsensitiveEqual); !errors.Is(err, ErrNilEqual) {
+		t.Errorf("SequenceMismatch2Eq() error = %v, expectedErr %v", err, ErrNilEqual)
+	}
+	tests := []struct {
+		name          string
+		first, second []string
+		want          Mismatch2[int, string]
+		wantFound     bool
+	}{
+		{name: "Equal", first: []string{"a", "b"}, second: []string{"a", "b"}},
+		{name: "DifferentValue", first: []string{"a", "b"}, second: []string{"a", "c"},
+			want: Mismatch2[int, string]{Index: 1, FirstKey: 1, FirstValue: "b", SecondKey: 1, SecondValue: "c"}, wantFound: true},
+		{name: "SecondShorter", first: []string{"a", "b"}, second: []string{"a"},
+			want: Mismatch2[int, string]{Index: 1, FirstKey: 1, FirstValue: "b", SecondEnded: true}, wantFound: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found, _ := SequenceMismatch2(SliceAll2(tt.first), SliceAll2(tt.second))
+			if got != tt.want || found != tt.wantFound {
+				t.Errorf("SequenceMismatch2() = %+v, %v, want %+v, %v", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func ExampleSequenceMismatch() {
+	want := strings.Fields("alpha beta gamma delta")
+	got := strings.Fields("alpha beta gamma")
+	if m, found, _ := SequenceMismatch(SliceAll(got), SliceAll(want)); found {
+		fmt.Println(m)
+	}
+	// Output:
+	// index 3: first ended, second delta
+}
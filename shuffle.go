@@ -0,0 +1,45 @@
+package go2linq
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+// randIntN returns a random int in [0, n) from 'rng' or from the top-level source if 'rng' is nil.
+func randIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+// randFloat64 returns a random float64 in [0.0, 1.0) from 'rng' or from the top-level source if 'rng' is nil.
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
+
+// [Shuffle] shuffles the order of the elements of a sequence using the Fisher–Yates algorithm.
+// Random numbers are taken from 'rng'. If 'rng' is nil, the top-level functions of [math/rand/v2] are used.
+// 'source' is enumerated completely when the result is enumerated, before the first element is yielded.
+// One Fisher–Yates step is performed per yielded element.
+//
+// [Shuffle]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.shuffle
+func Shuffle[Source any](source iter.Seq[Source], rng *rand.Rand) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("Shuffle", "source", ErrNilSource)
+	}
+	return func(yield func(Source) bool) {
+			ss, _ := ToSlice(source)
+			for i := range ss {
+				j := i + randIntN(rng, len(ss)-i)
+				ss[i], ss[j] = ss[j], ss[i]
+				if !yield(ss[i]) {
+					return
+				}
+			}
+		},
+		nil
+}
@@ -0,0 +1,60 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	if _, err := Shuffle[int](nil, nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Shuffle() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	source := RangeMust(0, 20)
+	got1, _ := Shuffle(source, rand.New(rand.NewPCG(1, 2)))
+	got2, _ := Shuffle(source, rand.New(rand.NewPCG(1, 2)))
+	s1, s2 := ToSliceMust(got1), ToSliceMust(got2)
+	if !slices.Equal(s1, s2) {
+		t.Errorf("Shuffle() with equal seeds = %v and %v", s1, s2)
+	}
+	if slices.Equal(s1, ToSliceMust(source)) {
+		t.Errorf("Shuffle() did not change the order: %v", s1)
+	}
+	sorted := slices.Clone(s1)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, ToSliceMust(source)) {
+		t.Errorf("Shuffle() = %v is not a permutation of the source", s1)
+	}
+	empty, _ := Shuffle(Empty[int](), nil)
+	if s := StringDef(empty); s != "[]" {
+		t.Errorf("Shuffle() = %v, want []", s)
+	}
+}
+
+func TestShuffle_uniform(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	const n = 30000
+	counts := make(map[string]int)
+	for range n {
+		got, _ := Shuffle(VarAll(1, 2, 3), rng)
+		counts[StringDef(got)]++
+	}
+	if len(counts) != 6 {
+		t.Fatalf("Shuffle() produced %d permutations, want 6", len(counts))
+	}
+	for p, c := range counts {
+		if c < n/6*9/10 || c > n/6*11/10 {
+			t.Errorf("permutation %v occurred %d times out of %d", p, c, n)
+		}
+	}
+}
+
+func ExampleShuffle() {
+	deck, _ := Shuffle(VarAll("A", "K", "Q", "J"), rand.New(rand.NewPCG(1, 2)))
+	hand, _ := Take(deck, 2)
+	fmt.Println(Count(hand))
+	// Output:
+	// 2 <nil>
+}
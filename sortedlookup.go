@@ -0,0 +1,128 @@
+package go2linq
+
+import (
+	"iter"
+	"sort"
+)
+
+// SortedLookup represents a collection of keys each mapped to one or more values.
+// Unlike [Lookup], groupings of SortedLookup are kept in ascending order of keys,
+// which allows binary search for keys and key range queries.
+type SortedLookup[Key, Element any] struct {
+	groupings []Grouping[Key, Element]
+	compare   func(Key, Key) int
+}
+
+// NewSortedLookup creates an empty [SortedLookup] that uses 'compare' to order keys.
+func NewSortedLookup[Key, Element any](compare func(Key, Key) int) (*SortedLookup[Key, Element], error) {
+	if compare == nil {
+		return nil, newOperatorError("NewSortedLookup", "compare", ErrNilCompare)
+	}
+	return &SortedLookup[Key, Element]{groupings: []Grouping[Key, Element]{}, compare: compare}, nil
+}
+
+// keyIdx searches for 'key' in sorted groupings.
+// keyIdx returns corresponding index (see https://pkg.go.dev/sort#Search)
+func (sl *SortedLookup[Key, Element]) keyIdx(key Key) int {
+	return sort.Search(len(sl.groupings), func(i int) bool {
+		return sl.compare(key, sl.groupings[i].key) <= 0
+	})
+}
+
+// keyIndex returns index of the grouping with 'key' or -1 if there is no such grouping.
+func (sl *SortedLookup[Key, Element]) keyIndex(key Key) int {
+	i := sl.keyIdx(key)
+	if i < len(sl.groupings) && sl.compare(key, sl.groupings[i].key) == 0 {
+		return i
+	}
+	return -1
+}
+
+// Add adds element 'el' with specified 'key' to 'sl'.
+func (sl *SortedLookup[Key, Element]) Add(key Key, el Element) {
+	i := sl.keyIdx(key)
+	if i < len(sl.groupings) && sl.compare(key, sl.groupings[i].key) == 0 {
+		sl.groupings[i].values = append(sl.groupings[i].values, el)
+		return
+	}
+	elIntoElelAtIdx(Grouping[Key, Element]{key: key, values: []Element{el}}, &sl.groupings, i)
+}
+
+// Count gets the number of key/value collection pairs in the SortedLookup.
+func (sl *SortedLookup[Key, Element]) Count() int {
+	return len(sl.groupings)
+}
+
+// Contains determines whether a specified key is in the SortedLookup.
+func (sl *SortedLookup[Key, Element]) Contains(key Key) bool {
+	return sl.keyIndex(key) >= 0
+}
+
+// Item gets the collection of values indexed by a specified key.
+func (sl *SortedLookup[Key, Element]) Item(key Key) iter.Seq[Element] {
+	i := sl.keyIndex(key)
+	if i < 0 {
+		return Empty[Element]()
+	}
+	return SliceAll(sl.groupings[i].values)
+}
+
+// ItemCount returns the number of values indexed by a specified key.
+// If 'key' is not in the SortedLookup, 0 is returned.
+func (sl *SortedLookup[Key, Element]) ItemCount(key Key) int {
+	i := sl.keyIndex(key)
+	if i < 0 {
+		return 0
+	}
+	return len(sl.groupings[i].values)
+}
+
+// Keys returns a sequence of the SortedLookup's keys in ascending order.
+func (sl *SortedLookup[Key, Element]) Keys() iter.Seq[Key] {
+	return func(yield func(Key) bool) {
+		for _, g := range sl.groupings {
+			if !yield(g.key) {
+				return
+			}
+		}
+	}
+}
+
+// Groupings returns a sequence of the SortedLookup's groupings in ascending order of keys.
+func (sl *SortedLookup[Key, Element]) Groupings() iter.Seq[Grouping[Key, Element]] {
+	return SliceAll(sl.groupings)
+}
+
+// Range returns a sequence of groupings with keys in the range ['lo', 'hi'] in ascending order of keys.
+func (sl *SortedLookup[Key, Element]) Range(lo, hi Key) iter.Seq[Grouping[Key, Element]] {
+	if sl.compare(lo, hi) > 0 {
+		return Empty[Grouping[Key, Element]]()
+	}
+	from := sl.keyIdx(lo)
+	to := sort.Search(len(sl.groupings), func(i int) bool {
+		return sl.compare(hi, sl.groupings[i].key) < 0
+	})
+	return SliceAll(sl.groupings[from:to])
+}
+
+// Floor returns the grouping with the greatest key less than or equal to 'key'.
+// Floor returns false if there is no such grouping.
+func (sl *SortedLookup[Key, Element]) Floor(key Key) (Grouping[Key, Element], bool) {
+	i := sort.Search(len(sl.groupings), func(i int) bool {
+		return sl.compare(key, sl.groupings[i].key) < 0
+	})
+	if i == 0 {
+		return Grouping[Key, Element]{}, false
+	}
+	return sl.groupings[i-1], true
+}
+
+// Ceiling returns the grouping with the least key greater than or equal to 'key'.
+// Ceiling returns false if there is no such grouping.
+func (sl *SortedLookup[Key, Element]) Ceiling(key Key) (Grouping[Key, Element], bool) {
+	i := sl.keyIdx(key)
+	if i == len(sl.groupings) {
+		return Grouping[Key, Element]{}, false
+	}
+	return sl.groupings[i], true
+}
@@ -0,0 +1,132 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+)
+
+// groupingsString returns string representation of a sequence of groupings.
+func groupingsString[Key, Element any](gg iter.Seq[Grouping[Key, Element]]) string {
+	var ss []string
+	for g := range gg {
+		ss = append(ss, fmt.Sprintf("%v:%s", g.Key(), StringDef(g.Values())))
+	}
+	return "[" + strings.Join(ss, " ") + "]"
+}
+
+func TestToSortedLookup(t *testing.T) {
+	if _, err := ToSortedLookup[int, int](nil, Identity[int]); !errors.Is(err, ErrNilSource) {
+		t.Errorf("ToSortedLookup() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := ToSortedLookup[int, int](VarAll(1), nil); !errors.Is(err, ErrNilSelector) {
+		t.Errorf("ToSortedLookup() error = %v, expectedErr %v", err, ErrNilSelector)
+	}
+	if _, err := ToSortedLookupCmp[int, int](VarAll(1), Identity[int], nil); !errors.Is(err, ErrNilCompare) {
+		t.Errorf("ToSortedLookupCmp() error = %v, expectedErr %v", err, ErrNilCompare)
+	}
+	tests := []struct {
+		name   string
+		source iter.Seq[string]
+		want   string
+	}{
+		{name: "Empty",
+			source: Empty[string](),
+			want:   "[]",
+		},
+		{name: "1",
+			source: VarAll("three", "one", "four", "five", "six", "two", "seven"),
+			want:   "[3:[one six two] 4:[four five] 5:[three seven]]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := ToSortedLookup(tt.source, func(s string) int { return len(s) })
+			if s := groupingsString(got.Groupings()); s != tt.want {
+				t.Errorf("ToSortedLookup() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestToSortedLookupCmp(t *testing.T) {
+	got, _ := ToSortedLookupCmp(VarAll("b", "A", "a", "C", "B"), Identity[string], caseInsensitiveCompare)
+	want := "[A:[A a] b:[b B] C:[C]]"
+	if s := groupingsString(got.Groupings()); s != want {
+		t.Errorf("ToSortedLookupCmp() = %v, want %v", s, want)
+	}
+	if !got.Contains("c") || got.ItemCount("B") != 2 || StringDef(got.Item("a")) != "[A a]" {
+		t.Errorf("ToSortedLookupCmp() lookup methods failed")
+	}
+	if got.Contains("d") || got.ItemCount("d") != 0 || StringDef(got.Item("d")) != "[]" {
+		t.Errorf("ToSortedLookupCmp() lookup methods failed for absent key")
+	}
+}
+
+func TestSortedLookup_queries(t *testing.T) {
+	sl, _ := ToSortedLookup(VarAll(10, 30, 20, 30, 50, 10), Identity[int])
+	if got := StringDef(sl.Keys()); got != "[10 20 30 50]" {
+		t.Errorf("Keys() = %v, want [10 20 30 50]", got)
+	}
+	rangeTests := []struct {
+		lo, hi int
+		want   string
+	}{
+		{lo: 0, hi: 100, want: "[10:[10 10] 20:[20] 30:[30 30] 50:[50]]"},
+		{lo: 20, hi: 30, want: "[20:[20] 30:[30 30]]"},
+		{lo: 15, hi: 45, want: "[20:[20] 30:[30 30]]"},
+		{lo: 31, hi: 49, want: "[]"},
+		{lo: 50, hi: 50, want: "[50:[50]]"},
+		{lo: 30, hi: 20, want: "[]"},
+	}
+	for _, tt := range rangeTests {
+		if got := groupingsString(sl.Range(tt.lo, tt.hi)); got != tt.want {
+			t.Errorf("Range(%d, %d) = %v, want %v", tt.lo, tt.hi, got, tt.want)
+		}
+	}
+	boundTests := []struct {
+		key                int
+		floor, ceiling     int
+		floorOk, ceilingOk bool
+	}{
+		{key: 5, ceiling: 10, ceilingOk: true},
+		{key: 10, floor: 10, floorOk: true, ceiling: 10, ceilingOk: true},
+		{key: 25, floor: 20, floorOk: true, ceiling: 30, ceilingOk: true},
+		{key: 50, floor: 50, floorOk: true, ceiling: 50, ceilingOk: true},
+		{key: 60, floor: 50, floorOk: true},
+	}
+	for _, tt := range boundTests {
+		f, ok := sl.Floor(tt.key)
+		if ok != tt.floorOk || (ok && f.Key() != tt.floor) {
+			t.Errorf("Floor(%d) = %v, %v, want %v, %v", tt.key, f.Key(), ok, tt.floor, tt.floorOk)
+		}
+		c, ok := sl.Ceiling(tt.key)
+		if ok != tt.ceilingOk || (ok && c.Key() != tt.ceiling) {
+			t.Errorf("Ceiling(%d) = %v, %v, want %v, %v", tt.key, c.Key(), ok, tt.ceiling, tt.ceilingOk)
+		}
+	}
+}
+
+func ExampleSortedLookup_Range() {
+	type event struct {
+		hour int
+		name string
+	}
+	events := []event{{9, "standup"}, {13, "lunch"}, {9, "coffee"}, {17, "retro"}, {11, "review"}}
+	sl, _ := ToSortedLookup(SliceAll(events), func(e event) int { return e.hour })
+	for g := range sl.Range(9, 12) {
+		for e := range g.Values() {
+			fmt.Println(g.Key(), e.name)
+		}
+	}
+	if g, ok := sl.Floor(15); ok {
+		fmt.Println("latest before 15:", g.Key())
+	}
+	// Output:
+	// 9 standup
+	// 9 coffee
+	// 11 review
+	// latest before 15: 13
+}
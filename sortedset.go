@@ -0,0 +1,101 @@
+package go2linq
+
+import (
+	"iter"
+	"slices"
+	"sort"
+)
+
+// SortedSet represents a set of distinct elements kept in ascending order.
+// SortedSet implements [Counter], [Itemer] and [Slicer].
+type SortedSet[T any] struct {
+	items   []T
+	compare func(T, T) int
+}
+
+// NewSortedSet creates an empty [SortedSet] that uses 'compare' to order elements.
+func NewSortedSet[T any](compare func(T, T) int) (*SortedSet[T], error) {
+	if compare == nil {
+		return nil, newOperatorError("NewSortedSet", "compare", ErrNilCompare)
+	}
+	return &SortedSet[T]{compare: compare}, nil
+}
+
+// idx searches for 't' in the SortedSet's elements.
+// idx returns corresponding index (see https://pkg.go.dev/sort#Search)
+// and whether the element at this index is equal to 't'.
+func (ss *SortedSet[T]) idx(t T) (int, bool) {
+	i := sort.Search(len(ss.items), func(i int) bool {
+		return ss.compare(t, ss.items[i]) <= 0
+	})
+	return i, i < len(ss.items) && ss.compare(t, ss.items[i]) == 0
+}
+
+// Add adds element 't' to the SortedSet.
+// Add returns false if the SortedSet already contains an element equal to 't'.
+func (ss *SortedSet[T]) Add(t T) bool {
+	i, found := ss.idx(t)
+	if found {
+		return false
+	}
+	elIntoElelAtIdx(t, &ss.items, i)
+	return true
+}
+
+// Contains determines whether the SortedSet contains an element equal to 't'.
+func (ss *SortedSet[T]) Contains(t T) bool {
+	_, found := ss.idx(t)
+	return found
+}
+
+// Remove removes the element equal to 't' from the SortedSet.
+// Remove returns false if there is no such element.
+func (ss *SortedSet[T]) Remove(t T) bool {
+	i, found := ss.idx(t)
+	if !found {
+		return false
+	}
+	ss.items = slices.Delete(ss.items, i, i+1)
+	return true
+}
+
+// Min returns the least element of the SortedSet.
+func (ss *SortedSet[T]) Min() (T, error) {
+	if len(ss.items) == 0 {
+		var t0 T
+		return t0, newOperatorError("SortedSet.Min", "", ErrEmptySource)
+	}
+	return ss.items[0], nil
+}
+
+// Max returns the greatest element of the SortedSet.
+func (ss *SortedSet[T]) Max() (T, error) {
+	if len(ss.items) == 0 {
+		var t0 T
+		return t0, newOperatorError("SortedSet.Max", "", ErrEmptySource)
+	}
+	return ss.items[len(ss.items)-1], nil
+}
+
+// Count implements the [Counter] interface.
+func (ss *SortedSet[T]) Count() int {
+	return len(ss.items)
+}
+
+// Item implements the [Itemer] interface.
+// Item returns the element at the specified index in ascending order.
+// Item panics if 'index' is out of range.
+func (ss *SortedSet[T]) Item(index int) T {
+	return ss.items[index]
+}
+
+// Slice implements the [Slicer] interface.
+// Slice returns a copy of the SortedSet's elements.
+func (ss *SortedSet[T]) Slice() []T {
+	return slices.Clone(ss.items)
+}
+
+// All returns a sequence of the SortedSet's elements in ascending order.
+func (ss *SortedSet[T]) All() iter.Seq[T] {
+	return SliceAll(ss.items)
+}
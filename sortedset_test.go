@@ -0,0 +1,76 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestNewSortedSet(t *testing.T) {
+	if _, err := NewSortedSet[int](nil); !errors.Is(err, ErrNilCompare) {
+		t.Errorf("NewSortedSet() error = %v, expectedErr %v", err, ErrNilCompare)
+	}
+	ss, _ := NewSortedSet(caseInsensitiveCompare)
+	if _, err := ss.Min(); !errors.Is(err, ErrEmptySource) {
+		t.Errorf("Min() error = %v, expectedErr %v", err, ErrEmptySource)
+	}
+	if _, err := ss.Max(); !errors.Is(err, ErrEmptySource) {
+		t.Errorf("Max() error = %v, expectedErr %v", err, ErrEmptySource)
+	}
+	for _, s := range []string{"c", "B", "a", "C", "b"} {
+		ss.Add(s)
+	}
+	if got := StringDef(ss.All()); got != "[a B c]" {
+		t.Errorf("All() = %v, want [a B c]", got)
+	}
+	if !ss.Remove("b") || ss.Remove("b") || ss.Contains("B") || !ss.Contains("C") {
+		t.Errorf("Remove() failed")
+	}
+	least, _ := ss.Min()
+	greatest, _ := ss.Max()
+	if ss.Count() != 2 || ss.Item(1) != "c" || least != "a" || greatest != "c" {
+		t.Errorf("SortedSet = %v", ss.Slice())
+	}
+}
+
+func TestToSortedSet(t *testing.T) {
+	if _, err := ToSortedSet[int](nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("ToSortedSet() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	_, err := ToSortedSetCmp[int](VarAll(1), nil)
+	if !errors.Is(err, ErrNilCompare) {
+		t.Errorf("ToSortedSetCmp() error = %v, expectedErr %v", err, ErrNilCompare)
+	}
+	var opErr *OperatorError
+	if !errors.As(err, &opErr) || opErr.Op != "ToSortedSetCmp" {
+		t.Errorf("ToSortedSetCmp() error = %v, want Op ToSortedSetCmp", err)
+	}
+	tests := []struct {
+		name string
+		args []int
+		want string
+	}{
+		{name: "Empty", args: nil, want: "[]"},
+		{name: "Sorted", args: []int{1, 2, 3}, want: "[1 2 3]"},
+		{name: "Duplicates", args: []int{5, 3, 5, 1, 3, 4}, want: "[1 3 4 5]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := ToSortedSet(SliceAll(tt.args))
+			if s := StringDef(got.All()); s != tt.want {
+				t.Errorf("ToSortedSet() = %v, want %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleToSortedSet() {
+	ss, _ := ToSortedSet(VarAll(42, 7, 19, 7, 3, 42))
+	fmt.Println(StringDef(ss.All()))
+	least, _ := ss.Min()
+	greatest, _ := ss.Max()
+	fmt.Println(least, greatest)
+	// Output:
+	// [3 7 19 42]
+	// 3 42
+}
@@ -0,0 +1,26 @@
+package go2linq
+
+import (
+	"iter"
+)
+
+// [ToHashSet] creates a [HashSet] from a sequence using specified 'equal' and 'hash'
+// to determine equality of elements.
+//
+// [ToHashSet]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.tohashset
+func ToHashSet[Source any](source iter.Seq[Source], equal func(Source, Source) bool, hash func(Source) uint64) (*HashSet[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("ToHashSet", "source", ErrNilSource)
+	}
+	if equal == nil {
+		return nil, newOperatorError("ToHashSet", "equal", ErrNilEqual)
+	}
+	if hash == nil {
+		return nil, newOperatorError("ToHashSet", "hash", ErrNilHash)
+	}
+	hs, _ := NewHashSet(equal, hash)
+	for s := range source {
+		hs.Add(s)
+	}
+	return hs, nil
+}
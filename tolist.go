@@ -0,0 +1,19 @@
+package go2linq
+
+import (
+	"iter"
+)
+
+// [ToList] creates a [List] from a sequence.
+//
+// [ToList]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.tolist
+func ToList[Source any](source iter.Seq[Source]) (*List[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("ToList", "source", ErrNilSource)
+	}
+	l := NewList[Source]()
+	for s := range source {
+		l.Add(s)
+	}
+	return l, nil
+}
@@ -2,22 +2,21 @@ package go2linq
 
 import (
 	"iter"
-
-	"github.com/solsw/generichelper"
 )
 
 // [ToLookup] creates a [Lookup] from a sequence according to a specified key selector function.
-// [generichelper.DeepEqual] is used to compare keys. 'source' is enumerated immediately.
+// [generichelper.DeepEqual] is used to compare keys, which are kept in hash buckets (see [DeepHash]).
+// 'source' is enumerated immediately.
 //
 // [ToLookup]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.tolookup
 func ToLookup[Source, Key any](source iter.Seq[Source], keySelector func(Source) Key) (*Lookup[Key, Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("ToLookup", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("ToLookup", "keySelector", ErrNilSelector)
 	}
-	return ToLookupSelEq(source, keySelector, Identity[Source], generichelper.DeepEqual[Key])
+	return ToLookupSelComparer(source, keySelector, Identity[Source], DeepComparer[Key]())
 }
 
 // [ToLookupEq] creates a [Lookup] from a sequence according to a specified key selector function and a key equaler.
@@ -27,13 +26,13 @@ func ToLookup[Source, Key any](source iter.Seq[Source], keySelector func(Source)
 func ToLookupEq[Source, Key any](source iter.Seq[Source],
 	keySelector func(Source) Key, equal func(Key, Key) bool) (*Lookup[Key, Source], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("ToLookupEq", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("ToLookupEq", "keySelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("ToLookupEq", "equal", ErrNilEqual)
 	}
 	return ToLookupSelEq(source, keySelector, Identity[Source], equal)
 }
@@ -45,12 +44,15 @@ func ToLookupEq[Source, Key any](source iter.Seq[Source],
 func ToLookupSel[Source, Key, Element any](source iter.Seq[Source],
 	keySelector func(Source) Key, elementSelector func(Source) Element) (*Lookup[Key, Element], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("ToLookupSel", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("ToLookupSel", "keySelector", ErrNilSelector)
 	}
-	if keySelector == nil || elementSelector == nil {
-		return nil, ErrNilSelector
+	if elementSelector == nil {
+		return nil, newOperatorError("ToLookupSel", "elementSelector", ErrNilSelector)
 	}
-	return ToLookupSelEq(source, keySelector, elementSelector, generichelper.DeepEqual[Key])
+	return ToLookupSelComparer(source, keySelector, elementSelector, DeepComparer[Key]())
 }
 
 // [ToLookupSelEq] creates a [Lookup] from a sequence according to
@@ -61,13 +63,16 @@ func ToLookupSel[Source, Key, Element any](source iter.Seq[Source],
 func ToLookupSelEq[Source, Key, Element any](source iter.Seq[Source],
 	keySelector func(Source) Key, elementSelector func(Source) Element, equal func(Key, Key) bool) (*Lookup[Key, Element], error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("ToLookupSelEq", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("ToLookupSelEq", "keySelector", ErrNilSelector)
 	}
-	if keySelector == nil || elementSelector == nil {
-		return nil, ErrNilSelector
+	if elementSelector == nil {
+		return nil, newOperatorError("ToLookupSelEq", "elementSelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("ToLookupSelEq", "equal", ErrNilEqual)
 	}
 	lk := &Lookup[Key, Element]{groupings: []Grouping[Key, Element]{}, KeyEqual: equal}
 	for s := range source {
@@ -76,3 +81,44 @@ func ToLookupSelEq[Source, Key, Element any](source iter.Seq[Source],
 	}
 	return lk, nil
 }
+
+// ToLookupComparer creates a [Lookup] from a sequence according to a specified key selector function.
+// The keys are compared using 'comparer'. 'source' is enumerated immediately.
+func ToLookupComparer[Source, Key any](source iter.Seq[Source],
+	keySelector func(Source) Key, comparer EqualityComparer[Key]) (*Lookup[Key, Source], error) {
+	if source == nil {
+		return nil, newOperatorError("ToLookupComparer", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("ToLookupComparer", "keySelector", ErrNilSelector)
+	}
+	if comparer == nil {
+		return nil, newOperatorError("ToLookupComparer", "comparer", ErrNilComparer)
+	}
+	return ToLookupSelComparer(source, keySelector, Identity[Source], comparer)
+}
+
+// ToLookupSelComparer creates a [Lookup] from a sequence according to
+// a specified key selector function and an element selector function.
+// The keys are compared using 'comparer'. 'source' is enumerated immediately.
+func ToLookupSelComparer[Source, Key, Element any](source iter.Seq[Source], keySelector func(Source) Key,
+	elementSelector func(Source) Element, comparer EqualityComparer[Key]) (*Lookup[Key, Element], error) {
+	if source == nil {
+		return nil, newOperatorError("ToLookupSelComparer", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("ToLookupSelComparer", "keySelector", ErrNilSelector)
+	}
+	if elementSelector == nil {
+		return nil, newOperatorError("ToLookupSelComparer", "elementSelector", ErrNilSelector)
+	}
+	if comparer == nil {
+		return nil, newOperatorError("ToLookupSelComparer", "comparer", ErrNilComparer)
+	}
+	lk := &Lookup[Key, Element]{groupings: []Grouping[Key, Element]{}}
+	lk.setComparer(comparer)
+	for s := range source {
+		lk.Add(keySelector(s), elementSelector(s))
+	}
+	return lk, nil
+}
@@ -1,6 +1,7 @@
 package go2linq
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"testing"
@@ -224,3 +225,27 @@ func ExampleToLookupSel() {
 	//
 	// false
 }
+
+func TestToLookupComparer(t *testing.T) {
+	if _, err := ToLookupComparer(VarAll("a"), Identity[string], nil); !errors.Is(err, ErrNilComparer) {
+		t.Errorf("ToLookupComparer() error = %v, expectedErr %v", err, ErrNilComparer)
+	}
+	lk, _ := ToLookupComparer(VarAll("one", "Two", "ONE", "three", "TWO"), Identity[string], CaseInsensitiveComparer)
+	if lk.Count() != 3 || StringDef(lk.Item("two")) != "[Two TWO]" || lk.Contains("four") {
+		t.Errorf("ToLookupComparer() = %v", lk)
+	}
+	// buckets must stay consistent after modifications
+	lk.Remove("One")
+	clone := lk.Clone()
+	clone.Add("THREE", "3")
+	lk.Merge(clone)
+	if got := StringDef(lk.Keys()); got != "[Two three]" {
+		t.Errorf("Keys() = %v, want [Two three]", got)
+	}
+	if got := StringDef(lk.Item("Three")); got != "[three three 3]" {
+		t.Errorf("Item(\"Three\") = %v, want [three three 3]", got)
+	}
+	if lk.Contains("one") || clone.Contains("one") || clone.ItemCount("three") != 2 {
+		t.Errorf("Lookup after Remove/Clone/Merge is inconsistent")
+	}
+}
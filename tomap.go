@@ -5,21 +5,22 @@ import (
 )
 
 // [ToMap] creates a [map] from a sequence according to a specified key selector function.
+// If a key occurs more than once, [DuplicateKeyError] is returned.
 //
 // [map]: https://go.dev/ref/spec#Map_types
 // [ToMap]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.todictionary
 func ToMap[Source any, Key comparable](source iter.Seq[Source], keySelector func(Source) Key) (map[Key]Source, error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("ToMap", "source", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("ToMap", "keySelector", ErrNilSelector)
 	}
 	m := make(map[Key]Source)
 	for s := range source {
 		k := keySelector(s)
 		if _, ok := m[k]; ok {
-			return nil, ErrDuplicateKeys
+			return nil, newOperatorError("ToMap", "", &DuplicateKeyError[Key]{Key: k})
 		}
 		m[k] = s
 	}
@@ -27,6 +28,7 @@ func ToMap[Source any, Key comparable](source iter.Seq[Source], keySelector func
 }
 
 // [ToMapSel] creates a [map] from a sequence according to specified key selector and element selector functions.
+// If a key occurs more than once, [DuplicateKeyError] is returned.
 //
 // Since Go's map does not support custom equaler to determine equality of the keys,
 // LINQ's key comparer is not implemented.
@@ -39,16 +41,19 @@ func ToMap[Source any, Key comparable](source iter.Seq[Source], keySelector func
 func ToMapSel[Source any, Key comparable, Element any](source iter.Seq[Source],
 	keySelector func(Source) Key, elementSelector func(Source) Element) (map[Key]Element, error) {
 	if source == nil {
-		return nil, ErrNilSource
+		return nil, newOperatorError("ToMapSel", "source", ErrNilSource)
 	}
-	if keySelector == nil || elementSelector == nil {
-		return nil, ErrNilSelector
+	if keySelector == nil {
+		return nil, newOperatorError("ToMapSel", "keySelector", ErrNilSelector)
+	}
+	if elementSelector == nil {
+		return nil, newOperatorError("ToMapSel", "elementSelector", ErrNilSelector)
 	}
 	m := make(map[Key]Element)
 	for s := range source {
 		k := keySelector(s)
 		if _, ok := m[k]; ok {
-			return nil, ErrDuplicateKeys
+			return nil, newOperatorError("ToMapSel", "", &DuplicateKeyError[Key]{Key: k})
 		}
 		m[k] = elementSelector(s)
 	}
@@ -0,0 +1,71 @@
+package go2linq
+
+import (
+	"iter"
+)
+
+// ToMapMerge creates a [map] from a sequence according to specified key selector and value selector functions.
+// If a key occurs more than once, 'merge' is called with the value already in the map ('old')
+// and the newly selected value ('new'), and the result replaces the value in the map.
+// Predefined merge policies are [KeepFirst] and [KeepLast].
+// To collect all values with the same key use [ToMapCollect].
+//
+// [map]: https://go.dev/ref/spec#Map_types
+func ToMapMerge[Source any, Key comparable, Value any](source iter.Seq[Source], keySelector func(Source) Key,
+	valueSelector func(Source) Value, merge func(old, new Value) Value) (map[Key]Value, error) {
+	if source == nil {
+		return nil, newOperatorError("ToMapMerge", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("ToMapMerge", "keySelector", ErrNilSelector)
+	}
+	if valueSelector == nil {
+		return nil, newOperatorError("ToMapMerge", "valueSelector", ErrNilSelector)
+	}
+	if merge == nil {
+		return nil, newOperatorError("ToMapMerge", "merge", ErrNilMerge)
+	}
+	m := make(map[Key]Value)
+	for s := range source {
+		k := keySelector(s)
+		v := valueSelector(s)
+		if old, ok := m[k]; ok {
+			v = merge(old, v)
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+// KeepFirst is a [ToMapMerge] merge policy that keeps the value of the first occurrence of a key.
+func KeepFirst[Value any](old, _ Value) Value {
+	return old
+}
+
+// KeepLast is a [ToMapMerge] merge policy that keeps the value of the last occurrence of a key.
+func KeepLast[Value any](_, new Value) Value {
+	return new
+}
+
+// ToMapCollect creates a [map] from a sequence according to specified key selector and value selector functions.
+// Values with the same key are collected into a slice in the order they occur in 'source'.
+//
+// [map]: https://go.dev/ref/spec#Map_types
+func ToMapCollect[Source any, Key comparable, Value any](source iter.Seq[Source],
+	keySelector func(Source) Key, valueSelector func(Source) Value) (map[Key][]Value, error) {
+	if source == nil {
+		return nil, newOperatorError("ToMapCollect", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("ToMapCollect", "keySelector", ErrNilSelector)
+	}
+	if valueSelector == nil {
+		return nil, newOperatorError("ToMapCollect", "valueSelector", ErrNilSelector)
+	}
+	m := make(map[Key][]Value)
+	for s := range source {
+		k := keySelector(s)
+		m[k] = append(m[k], valueSelector(s))
+	}
+	return m, nil
+}
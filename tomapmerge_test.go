@@ -0,0 +1,134 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type setting struct {
+	key   string
+	value int
+}
+
+func settingKey(s setting) string { return s.key }
+func settingValue(s setting) int  { return s.value }
+
+var settings = []setting{{"a", 1}, {"b", 2}, {"a", 3}, {"c", 4}, {"b", 5}}
+
+func TestToMapMerge(t *testing.T) {
+	type args struct {
+		source iter.Seq[setting]
+		merge  func(int, int) int
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        map[string]int
+		wantErr     bool
+		expectedErr error
+	}{
+		{name: "NilSource",
+			args: args{
+				merge: KeepFirst[int],
+			},
+			wantErr:     true,
+			expectedErr: ErrNilSource,
+		},
+		{name: "NilMerge",
+			args: args{
+				source: SliceAll(settings),
+			},
+			wantErr:     true,
+			expectedErr: ErrNilMerge,
+		},
+		{name: "KeepFirst",
+			args: args{
+				source: SliceAll(settings),
+				merge:  KeepFirst[int],
+			},
+			want: map[string]int{"a": 1, "b": 2, "c": 4},
+		},
+		{name: "KeepLast",
+			args: args{
+				source: SliceAll(settings),
+				merge:  KeepLast[int],
+			},
+			want: map[string]int{"a": 3, "b": 5, "c": 4},
+		},
+		{name: "Sum",
+			args: args{
+				source: SliceAll(settings),
+				merge:  func(old, new int) int { return old + new },
+			},
+			want: map[string]int{"a": 4, "b": 7, "c": 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToMapMerge(tt.args.source, settingKey, settingValue, tt.args.merge)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToMapMerge() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("ToMapMerge() error = %v, expectedErr %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToMapMerge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMapCollect(t *testing.T) {
+	if _, err := ToMapCollect(SliceAll(settings), settingKey, (func(setting) int)(nil)); !errors.Is(err, ErrNilSelector) {
+		t.Errorf("ToMapCollect() error = %v, expectedErr %v", err, ErrNilSelector)
+	}
+	got, _ := ToMapCollect(SliceAll(settings), settingKey, settingValue)
+	want := map[string][]int{"a": {1, 3}, "b": {2, 5}, "c": {4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMapCollect() = %v, want %v", got, want)
+	}
+}
+
+func TestDuplicateKeyError(t *testing.T) {
+	_, err := ToMapSel(SliceAll(settings), settingKey, settingValue)
+	var dke *DuplicateKeyError[string]
+	if !errors.As(err, &dke) || dke.Key != "a" {
+		t.Fatalf("ToMapSel() error = %v, want DuplicateKeyError with key \"a\"", err)
+	}
+	if !errors.Is(err, ErrDuplicateKeys) {
+		t.Errorf("errors.Is(%v, ErrDuplicateKeys) = false", err)
+	}
+	if got := err.Error(); got != "ToMapSel: duplicate keys: a" {
+		t.Errorf("Error() = %q, want %q", got, "ToMapSel: duplicate keys: a")
+	}
+}
+
+func ExampleToMapMerge() {
+	defaults := []setting{{"timeout", 30}, {"retries", 3}}
+	overrides := []setting{{"timeout", 60}}
+	all, _ := Concat(SliceAll(defaults), SliceAll(overrides))
+	config, _ := ToMapMerge(all, settingKey, settingValue, KeepLast[int])
+	fmt.Println(config["timeout"], config["retries"])
+	// Output:
+	// 60 3
+}
+
+func ExampleToMapCollect() {
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	byLetter, _ := ToMapCollect(SliceAll(words),
+		func(s string) string { return strings.ToUpper(s[:1]) },
+		Identity[string],
+	)
+	fmt.Println(byLetter["A"], byLetter["B"], byLetter["C"])
+	// Output:
+	// [apple avocado] [banana blueberry] [cherry]
+}
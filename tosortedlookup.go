@@ -0,0 +1,38 @@
+package go2linq
+
+import (
+	"cmp"
+	"iter"
+)
+
+// ToSortedLookup creates a [SortedLookup] from a sequence according to a specified key selector function.
+// Keys are ordered using [cmp.Compare]. 'source' is enumerated immediately.
+func ToSortedLookup[Source any, Key cmp.Ordered](source iter.Seq[Source], keySelector func(Source) Key) (*SortedLookup[Key, Source], error) {
+	if source == nil {
+		return nil, newOperatorError("ToSortedLookup", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("ToSortedLookup", "keySelector", ErrNilSelector)
+	}
+	return ToSortedLookupCmp(source, keySelector, cmp.Compare[Key])
+}
+
+// ToSortedLookupCmp creates a [SortedLookup] from a sequence according to a specified key selector function
+// and using a specified 'compare' to order keys. 'source' is enumerated immediately.
+func ToSortedLookupCmp[Source, Key any](source iter.Seq[Source],
+	keySelector func(Source) Key, compare func(Key, Key) int) (*SortedLookup[Key, Source], error) {
+	if source == nil {
+		return nil, newOperatorError("ToSortedLookupCmp", "source", ErrNilSource)
+	}
+	if keySelector == nil {
+		return nil, newOperatorError("ToSortedLookupCmp", "keySelector", ErrNilSelector)
+	}
+	if compare == nil {
+		return nil, newOperatorError("ToSortedLookupCmp", "compare", ErrNilCompare)
+	}
+	sl, _ := NewSortedLookup[Key, Source](compare)
+	for s := range source {
+		sl.Add(keySelector(s), s)
+	}
+	return sl, nil
+}
@@ -0,0 +1,29 @@
+package go2linq
+
+import (
+	"cmp"
+	"iter"
+)
+
+// ToSortedSet creates a [SortedSet] from a sequence. Elements are ordered using [cmp.Compare].
+func ToSortedSet[Source cmp.Ordered](source iter.Seq[Source]) (*SortedSet[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("ToSortedSet", "source", ErrNilSource)
+	}
+	return ToSortedSetCmp(source, cmp.Compare[Source])
+}
+
+// ToSortedSetCmp creates a [SortedSet] from a sequence using a specified 'compare' to order elements.
+func ToSortedSetCmp[Source any](source iter.Seq[Source], compare func(Source, Source) int) (*SortedSet[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("ToSortedSetCmp", "source", ErrNilSource)
+	}
+	if compare == nil {
+		return nil, newOperatorError("ToSortedSetCmp", "compare", ErrNilCompare)
+	}
+	ss, _ := NewSortedSet(compare)
+	for s := range source {
+		ss.Add(s)
+	}
+	return ss, nil
+}
@@ -0,0 +1,147 @@
+package go2linq
+
+import (
+	"context"
+	"iter"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Tap performs a specified 'action' on each element of a sequence as it is enumerated
+// and passes the element through unchanged.
+func Tap[Source any](source iter.Seq[Source], action func(Source)) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("Tap", "source", ErrNilSource)
+	}
+	if action == nil {
+		return nil, newOperatorError("Tap", "action", ErrNilAction)
+	}
+	return func(yield func(Source) bool) {
+			for s := range source {
+				action(s)
+				if !yield(s) {
+					return
+				}
+			}
+		},
+		nil
+}
+
+// StatsSnapshot contains statistics of traced sequence enumerations.
+type StatsSnapshot struct {
+	// Name is the name of the traced sequence.
+	Name string
+	// Enumerations is the number of enumerations of the traced sequence.
+	Enumerations int
+	// Produced is the number of elements produced by the traced sequence.
+	Produced int
+	// Pulled is the number of elements for which the consumer asked for the next element.
+	// Pulled is less than Produced if the consumer stopped early.
+	Pulled int
+	// StoppedEarly reports whether the consumer stopped any enumeration before the end of the sequence.
+	StoppedEarly bool
+	// Elapsed is the total time spent in enumerations.
+	Elapsed time.Duration
+	// ConsumerTime is the part of Elapsed spent by the consumer processing the elements.
+	ConsumerTime time.Duration
+}
+
+// SourceTime returns the part of [StatsSnapshot.Elapsed] spent by the traced sequence producing the elements.
+func (ss StatsSnapshot) SourceTime() time.Duration {
+	return ss.Elapsed - ss.ConsumerTime
+}
+
+// Throughput returns the number of elements produced by the traced sequence per second of [StatsSnapshot.SourceTime].
+func (ss StatsSnapshot) Throughput() float64 {
+	st := ss.SourceTime()
+	if st <= 0 {
+		return 0
+	}
+	return float64(ss.Produced) / st.Seconds()
+}
+
+// LogValue implements the [slog.LogValuer] interface.
+func (ss StatsSnapshot) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", ss.Name),
+		slog.Int("enumerations", ss.Enumerations),
+		slog.Int("produced", ss.Produced),
+		slog.Int("pulled", ss.Pulled),
+		slog.Bool("stopped_early", ss.StoppedEarly),
+		slog.Duration("elapsed", ss.Elapsed),
+		slog.Duration("source_time", ss.SourceTime()),
+		slog.Float64("throughput", ss.Throughput()),
+	)
+}
+
+// Stats accumulates statistics of traced sequence enumerations (see [Trace]).
+// Stats is safe for concurrent use. The zero value is ready to use.
+type Stats struct {
+	// Logger, if not nil, is used to log statistics (at [slog.LevelDebug]) after each enumeration.
+	Logger *slog.Logger
+
+	mu sync.Mutex
+	ss StatsSnapshot
+}
+
+// Snapshot returns the current statistics.
+func (st *Stats) Snapshot() StatsSnapshot {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.ss
+}
+
+// Reset clears the accumulated statistics.
+func (st *Stats) Reset() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.ss = StatsSnapshot{}
+}
+
+func (st *Stats) add(name string, produced, pulled int, stoppedEarly bool, elapsed, consumerTime time.Duration) {
+	st.mu.Lock()
+	st.ss.Name = name
+	st.ss.Enumerations++
+	st.ss.Produced += produced
+	st.ss.Pulled += pulled
+	st.ss.StoppedEarly = st.ss.StoppedEarly || stoppedEarly
+	st.ss.Elapsed += elapsed
+	st.ss.ConsumerTime += consumerTime
+	ss := st.ss
+	st.mu.Unlock()
+	if st.Logger != nil {
+		st.Logger.LogAttrs(context.Background(), slog.LevelDebug, "go2linq trace", slog.Any("stats", ss))
+	}
+}
+
+// Trace records statistics of each enumeration of a sequence into 'stats'
+// and passes the elements through unchanged.
+// 'name' identifies the traced sequence in 'stats' and in the log.
+func Trace[Source any](source iter.Seq[Source], name string, stats *Stats) (iter.Seq[Source], error) {
+	if source == nil {
+		return nil, newOperatorError("Trace", "source", ErrNilSource)
+	}
+	if stats == nil {
+		return nil, newOperatorError("Trace", "stats", ErrNilStats)
+	}
+	return func(yield func(Source) bool) {
+			produced, pulled := 0, 0
+			stoppedEarly := false
+			var consumerTime time.Duration
+			start := time.Now()
+			for s := range source {
+				produced++
+				yieldStart := time.Now()
+				ok := yield(s)
+				consumerTime += time.Since(yieldStart)
+				if !ok {
+					stoppedEarly = true
+					break
+				}
+				pulled++
+			}
+			stats.add(name, produced, pulled, stoppedEarly, time.Since(start), consumerTime)
+		},
+		nil
+}
@@ -0,0 +1,141 @@
+package go2linq
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"iter"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestTap_int(t *testing.T) {
+	type args struct {
+		source iter.Seq[int]
+		action func(int)
+	}
+	var tapped []int
+	tests := []struct {
+		name        string
+		args        args
+		want        iter.Seq[int]
+		wantTapped  []int
+		wantErr     bool
+		expectedErr error
+	}{
+		{name: "NilSource",
+			args: args{
+				action: func(int) {},
+			},
+			wantErr:     true,
+			expectedErr: ErrNilSource,
+		},
+		{name: "NilAction",
+			args: args{
+				source: VarAll(1, 2, 3),
+			},
+			wantErr:     true,
+			expectedErr: ErrNilAction,
+		},
+		{name: "1",
+			args: args{
+				source: VarAll(1, 2, 3),
+				action: func(i int) { tapped = append(tapped, i*10) },
+			},
+			want:       VarAll(1, 2, 3),
+			wantTapped: []int{10, 20, 30},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tapped = nil
+			got, err := Tap(tt.args.source, tt.args.action)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Tap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Tap() error = %v, expectedErr %v", err, tt.expectedErr)
+				}
+				return
+			}
+			equal, _ := SequenceEqual(got, tt.want)
+			if !equal {
+				t.Errorf("Tap() = %v, want %v", StringDef(got), StringDef(tt.want))
+			}
+			equal, _ = SequenceEqual(SliceAll(tapped), SliceAll(tt.wantTapped))
+			if !equal {
+				t.Errorf("Tap() tapped %v, want %v", tapped, tt.wantTapped)
+			}
+		})
+	}
+}
+
+func TestTrace_int(t *testing.T) {
+	if _, err := Trace[int](nil, "n", &Stats{}); !errors.Is(err, ErrNilSource) {
+		t.Errorf("Trace() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := Trace(VarAll(1), "n", nil); !errors.Is(err, ErrNilStats) {
+		t.Errorf("Trace() error = %v, expectedErr %v", err, ErrNilStats)
+	}
+	var stats Stats
+	rng, _ := Range(1, 10)
+	trace, _ := Trace(rng, "range", &stats)
+	// full enumeration
+	Count(trace)
+	ss := stats.Snapshot()
+	want := StatsSnapshot{Name: "range", Enumerations: 1, Produced: 10, Pulled: 10}
+	if ss.Name != want.Name || ss.Enumerations != want.Enumerations || ss.Produced != want.Produced ||
+		ss.Pulled != want.Pulled || ss.StoppedEarly != want.StoppedEarly {
+		t.Errorf("Trace() stats = %+v, want %+v", ss, want)
+	}
+	if ss.Elapsed <= 0 || ss.ConsumerTime > ss.Elapsed {
+		t.Errorf("Trace() stats times Elapsed = %v, ConsumerTime = %v", ss.Elapsed, ss.ConsumerTime)
+	}
+	// early stop
+	take, _ := Take(trace, 3)
+	Count(take)
+	ss = stats.Snapshot()
+	want = StatsSnapshot{Name: "range", Enumerations: 2, Produced: 13, Pulled: 12, StoppedEarly: true}
+	if ss.Name != want.Name || ss.Enumerations != want.Enumerations || ss.Produced != want.Produced ||
+		ss.Pulled != want.Pulled || ss.StoppedEarly != want.StoppedEarly {
+		t.Errorf("Trace() stats = %+v, want %+v", ss, want)
+	}
+	stats.Reset()
+	if ss = stats.Snapshot(); ss != (StatsSnapshot{}) {
+		t.Errorf("Reset() stats = %+v", ss)
+	}
+}
+
+func TestTrace_Logger(t *testing.T) {
+	var buf bytes.Buffer
+	stats := Stats{Logger: slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))}
+	trace, _ := Trace(VarAll("a", "b", "c"), "letters", &stats)
+	First(trace)
+	got := buf.String()
+	for _, want := range []string{"stats.name=letters", "stats.produced=1", "stats.pulled=0", "stats.stopped_early=true"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Trace() log = %q, does not contain %q", got, want)
+		}
+	}
+}
+
+func ExampleTrace() {
+	var whereStats, selectStats Stats
+	rng, _ := Range(1, 1000)
+	trace1, _ := Trace(rng, "range", &whereStats)
+	where, _ := Where(trace1, func(i int) bool { return i%7 == 0 })
+	sel, _ := Select(where, func(i int) string { return fmt.Sprint(i) })
+	trace2, _ := Trace(sel, "select", &selectStats)
+	take, _ := Take(trace2, 3)
+	fmt.Println(StringDef(take))
+	for _, ss := range []StatsSnapshot{whereStats.Snapshot(), selectStats.Snapshot()} {
+		fmt.Printf("%s: produced %d, pulled %d, stopped early %t\n", ss.Name, ss.Produced, ss.Pulled, ss.StoppedEarly)
+	}
+	// Output:
+	// [7 14 21]
+	// range: produced 21, pulled 20, stopped early true
+	// select: produced 3, pulled 2, stopped early true
+}
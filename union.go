@@ -2,29 +2,34 @@ package go2linq
 
 import (
 	"iter"
-
-	"github.com/solsw/generichelper"
 )
 
 // [Union] produces the set union of two sequences using [generichelper.DeepEqual] to compare values.
+// Already seen elements are kept in hash buckets (see [DeepHash]).
 //
 // [Union]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.union
 func Union[Source any](first, second iter.Seq[Source]) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("Union", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("Union", "second", ErrNilSource)
 	}
-	return UnionEq(first, second, generichelper.DeepEqual[Source])
+	return UnionComparer(first, second, DeepComparer[Source]())
 }
 
 // [UnionEq] produces the set union of two sequences using 'equal' to compare values.
 //
 // [UnionEq]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.union
 func UnionEq[Source any](first, second iter.Seq[Source], equal func(Source, Source) bool) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("UnionEq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("UnionEq", "second", ErrNilSource)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("UnionEq", "equal", ErrNilEqual)
 	}
 	concat, _ := Concat(first, second)
 	return DistinctEq(concat, equal)
@@ -34,12 +39,30 @@ func UnionEq[Source any](first, second iter.Seq[Source], equal func(Source, Sour
 //
 // [UnionCmp]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.union
 func UnionCmp[Source any](first, second iter.Seq[Source], compare func(Source, Source) int) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("UnionCmp", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("UnionCmp", "second", ErrNilSource)
 	}
 	if compare == nil {
-		return nil, ErrNilCompare
+		return nil, newOperatorError("UnionCmp", "compare", ErrNilCompare)
 	}
 	concat, _ := Concat(first, second)
 	return DistinctCmp(concat, compare)
 }
+
+// UnionComparer produces the set union of two sequences using 'comparer' to compare values. (See [DistinctComparer].)
+func UnionComparer[Source any](first, second iter.Seq[Source], comparer EqualityComparer[Source]) (iter.Seq[Source], error) {
+	if first == nil {
+		return nil, newOperatorError("UnionComparer", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("UnionComparer", "second", ErrNilSource)
+	}
+	if comparer == nil {
+		return nil, newOperatorError("UnionComparer", "comparer", ErrNilComparer)
+	}
+	concat, _ := Concat(first, second)
+	return DistinctComparer(concat, comparer)
+}
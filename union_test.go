@@ -2,6 +2,7 @@ package go2linq
 
 import (
 	"cmp"
+	"errors"
 	"fmt"
 	"iter"
 	"testing"
@@ -315,3 +316,13 @@ func ExampleUnionEq() {
 	// orange 4
 	// lemon 12
 }
+
+func TestUnionComparer(t *testing.T) {
+	if _, err := UnionComparer(VarAll("a"), nil, CaseInsensitiveComparer); !errors.Is(err, ErrNilSource) {
+		t.Errorf("UnionComparer() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	got, _ := UnionComparer(VarAll("a", "b", "B", "c"), VarAll("D", "A", "d"), CaseInsensitiveComparer)
+	if s := StringDef(got); s != "[a b c D]" {
+		t.Errorf("UnionComparer() = %v, want [a b c D]", s)
+	}
+}
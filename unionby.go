@@ -11,11 +11,14 @@ import (
 //
 // [UnionBy]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.unionby
 func UnionBy[Source, Key any](first, second iter.Seq[Source], keySelector func(Source) Key) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("UnionBy", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("UnionBy", "second", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("UnionBy", "keySelector", ErrNilSelector)
 	}
 	return UnionByEq(first, second, keySelector, generichelper.DeepEqual[Key])
 }
@@ -26,14 +29,17 @@ func UnionBy[Source, Key any](first, second iter.Seq[Source], keySelector func(S
 // [UnionByEq]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.unionby
 func UnionByEq[Source, Key any](first, second iter.Seq[Source],
 	keySelector func(Source) Key, equal func(Key, Key) bool) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("UnionByEq", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("UnionByEq", "second", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("UnionByEq", "keySelector", ErrNilSelector)
 	}
 	if equal == nil {
-		return nil, ErrNilEqual
+		return nil, newOperatorError("UnionByEq", "equal", ErrNilEqual)
 	}
 	concat, _ := Concat(first, second)
 	return DistinctByEq(concat, keySelector, equal)
@@ -45,14 +51,17 @@ func UnionByEq[Source, Key any](first, second iter.Seq[Source],
 // [UnionByCmp]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.unionby
 func UnionByCmp[Source, Key any](first, second iter.Seq[Source],
 	keySelector func(Source) Key, compare func(Key, Key) int) (iter.Seq[Source], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("UnionByCmp", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("UnionByCmp", "second", ErrNilSource)
 	}
 	if keySelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("UnionByCmp", "keySelector", ErrNilSelector)
 	}
 	if compare == nil {
-		return nil, ErrNilCompare
+		return nil, newOperatorError("UnionByCmp", "compare", ErrNilCompare)
 	}
 	concat, _ := Concat(first, second)
 	return DistinctByCmp(concat, keySelector, compare)
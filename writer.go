@@ -0,0 +1,121 @@
+package go2linq
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// Each element is written to 'w' by a separate Write call as soon as it is enumerated,
+// so the sinks below do not buffer the sequence.
+// Wrap 'w' with [bufio.Writer] to reduce the number of Write calls.
+
+// WriteLines writes each element of a sequence to 'w' as a line formatted according to a 'format' verb
+// (e.g. "%v", "%q", "%08.3f"; if 'format' is empty, "%v" is used).
+// WriteLines returns the number of elements written and the first write error encountered.
+func WriteLines[Source any](w io.Writer, source iter.Seq[Source], format string) (int, error) {
+	if w == nil {
+		return 0, newOperatorError("WriteLines", "w", ErrNilWriter)
+	}
+	if source == nil {
+		return 0, newOperatorError("WriteLines", "source", ErrNilSource)
+	}
+	if format == "" {
+		format = "%v"
+	}
+	format += "\n"
+	n := 0
+	for s := range source {
+		if _, err := fmt.Fprintf(w, format, s); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// WriteCSV writes each element of a sequence to 'w' as a [CSV] record produced by 'rowFunc'.
+// WriteCSV returns the number of elements written and the first write error encountered.
+//
+// [CSV]: https://www.rfc-editor.org/rfc/rfc4180.html
+func WriteCSV[Source any](w io.Writer, source iter.Seq[Source], rowFunc func(Source) []string) (int, error) {
+	if w == nil {
+		return 0, newOperatorError("WriteCSV", "w", ErrNilWriter)
+	}
+	if source == nil {
+		return 0, newOperatorError("WriteCSV", "source", ErrNilSource)
+	}
+	if rowFunc == nil {
+		return 0, newOperatorError("WriteCSV", "rowFunc", ErrNilSelector)
+	}
+	cw := csv.NewWriter(w)
+	n := 0
+	for s := range source {
+		if err := cw.Write(rowFunc(s)); err != nil {
+			return n, err
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// WriteJSONLines writes each element of a sequence to 'w' as a line of [NDJSON].
+// WriteJSONLines returns the number of elements written and the first encoding or write error encountered.
+//
+// [NDJSON]: https://github.com/ndjson/ndjson-spec
+func WriteJSONLines[Source any](w io.Writer, source iter.Seq[Source]) (int, error) {
+	if w == nil {
+		return 0, newOperatorError("WriteJSONLines", "w", ErrNilWriter)
+	}
+	if source == nil {
+		return 0, newOperatorError("WriteJSONLines", "source", ErrNilSource)
+	}
+	enc := json.NewEncoder(w)
+	n := 0
+	for s := range source {
+		if err := enc.Encode(s); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// WriteJSONArray writes a sequence to 'w' as a JSON array.
+// WriteJSONArray returns the number of elements written and the first encoding or write error encountered.
+// If an error occurs, the array written to 'w' is incomplete.
+func WriteJSONArray[Source any](w io.Writer, source iter.Seq[Source]) (int, error) {
+	if w == nil {
+		return 0, newOperatorError("WriteJSONArray", "w", ErrNilWriter)
+	}
+	if source == nil {
+		return 0, newOperatorError("WriteJSONArray", "source", ErrNilSource)
+	}
+	if _, err := io.WriteString(w, "["); err != nil {
+		return 0, err
+	}
+	n := 0
+	for s := range source {
+		bb, err := json.Marshal(s)
+		if err != nil {
+			return n, err
+		}
+		if n > 0 {
+			bb = append([]byte{','}, bb...)
+		}
+		if _, err := w.Write(bb); err != nil {
+			return n, err
+		}
+		n++
+	}
+	if _, err := io.WriteString(w, "]\n"); err != nil {
+		return n, err
+	}
+	return n, nil
+}
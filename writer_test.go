@@ -0,0 +1,137 @@
+package go2linq
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// limitWriter fails with ErrTestError after 'limit' Write calls.
+type limitWriter struct {
+	sb    strings.Builder
+	limit int
+}
+
+func (lw *limitWriter) Write(p []byte) (int, error) {
+	if lw.limit <= 0 {
+		return 0, ErrTestError
+	}
+	lw.limit--
+	return lw.sb.Write(p)
+}
+
+type writeSink func(io.Writer, iter.Seq[Pet]) (int, error)
+
+func TestWriteSinks(t *testing.T) {
+	pets := []Pet{{Name: "Barley", Age: 8}, {Name: "Boots", Age: 4}, {Name: "Whiskers", Age: 1}}
+	writeLines := func(w io.Writer, seq iter.Seq[Pet]) (int, error) {
+		names, _ := Select(seq, func(p Pet) string { return p.Name })
+		return WriteLines(w, names, "%q")
+	}
+	writeCSV := func(w io.Writer, seq iter.Seq[Pet]) (int, error) {
+		return WriteCSV(w, seq, func(p Pet) []string { return []string{p.Name, strconv.Itoa(p.Age)} })
+	}
+	writeJSONLines := func(w io.Writer, seq iter.Seq[Pet]) (int, error) {
+		names, _ := Select(seq, func(p Pet) map[string]int { return map[string]int{p.Name: p.Age} })
+		return WriteJSONLines(w, names)
+	}
+	writeJSONArray := func(w io.Writer, seq iter.Seq[Pet]) (int, error) {
+		ages, _ := Select(seq, func(p Pet) int { return p.Age })
+		return WriteJSONArray(w, ages)
+	}
+	tests := []struct {
+		name    string
+		sink    writeSink
+		source  iter.Seq[Pet]
+		limit   int
+		want    string
+		wantN   int
+		wantErr error
+	}{
+		{name: "Lines", sink: writeLines, source: SliceAll(pets), limit: 100,
+			want: "\"Barley\"\n\"Boots\"\n\"Whiskers\"\n", wantN: 3},
+		{name: "LinesEmpty", sink: writeLines, source: Empty[Pet](), limit: 100},
+		{name: "LinesError", sink: writeLines, source: SliceAll(pets), limit: 2,
+			want: "\"Barley\"\n\"Boots\"\n", wantN: 2, wantErr: ErrTestError},
+		{name: "CSV", sink: writeCSV, source: SliceAll(pets), limit: 100,
+			want: "Barley,8\nBoots,4\nWhiskers,1\n", wantN: 3},
+		{name: "CSVError", sink: writeCSV, source: SliceAll(pets), limit: 1,
+			want: "Barley,8\n", wantN: 1, wantErr: ErrTestError},
+		{name: "JSONLines", sink: writeJSONLines, source: SliceAll(pets), limit: 100,
+			want: "{\"Barley\":8}\n{\"Boots\":4}\n{\"Whiskers\":1}\n", wantN: 3},
+		{name: "JSONLinesError", sink: writeJSONLines, source: SliceAll(pets), limit: 0,
+			wantErr: ErrTestError},
+		{name: "JSONArray", sink: writeJSONArray, source: SliceAll(pets), limit: 100,
+			want: "[8,4,1]\n", wantN: 3},
+		{name: "JSONArrayEmpty", sink: writeJSONArray, source: Empty[Pet](), limit: 100,
+			want: "[]\n"},
+		{name: "JSONArrayError", sink: writeJSONArray, source: SliceAll(pets), limit: 3,
+			want: "[8,4", wantN: 2, wantErr: ErrTestError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &limitWriter{limit: tt.limit}
+			n, err := tt.sink(w, tt.source)
+			if err != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if n != tt.wantN {
+				t.Errorf("n = %d, want %d", n, tt.wantN)
+			}
+			if got := w.sb.String(); got != tt.want {
+				t.Errorf("written = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteSinks_errors(t *testing.T) {
+	var sb strings.Builder
+	if _, err := WriteLines(nil, VarAll(1), ""); !errors.Is(err, ErrNilWriter) {
+		t.Errorf("WriteLines() error = %v, expectedErr %v", err, ErrNilWriter)
+	}
+	if _, err := WriteLines[int](&sb, nil, ""); !errors.Is(err, ErrNilSource) {
+		t.Errorf("WriteLines() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := WriteCSV(&sb, VarAll(1), nil); !errors.Is(err, ErrNilSelector) {
+		t.Errorf("WriteCSV() error = %v, expectedErr %v", err, ErrNilSelector)
+	}
+	if _, err := WriteJSONLines[int](&sb, nil); !errors.Is(err, ErrNilSource) {
+		t.Errorf("WriteJSONLines() error = %v, expectedErr %v", err, ErrNilSource)
+	}
+	if _, err := WriteJSONArray(nil, VarAll(1)); !errors.Is(err, ErrNilWriter) {
+		t.Errorf("WriteJSONArray() error = %v, expectedErr %v", err, ErrNilWriter)
+	}
+	if n, err := WriteJSONArray(&sb, VarAll(func() {})); err == nil || n != 0 {
+		t.Errorf("WriteJSONArray() = %d, %v, want encoding error", n, err)
+	}
+}
+
+func ExampleWriteCSV() {
+	rng, _ := Range(1, 4)
+	n, err := WriteCSV(os.Stdout, rng, func(i int) []string {
+		return []string{strconv.Itoa(i), strconv.Itoa(i * i), fmt.Sprintf("%d, squared", i)}
+	})
+	fmt.Println(n, err)
+	// Output:
+	// 1,1,"1, squared"
+	// 2,4,"2, squared"
+	// 3,9,"3, squared"
+	// 4,16,"4, squared"
+	// 4 <nil>
+}
+
+func ExampleWriteJSONLines() {
+	WriteJSONLines(os.Stdout, VarAll(
+		Product{Name: "apple", Code: 9},
+		Product{Name: "orange", Code: 4},
+	))
+	// Output:
+	// {"Name":"apple","Code":9,"CategoryId":0}
+	// {"Name":"orange","Code":4,"CategoryId":0}
+}
@@ -10,11 +10,14 @@ import (
 // [Zip]: https://learn.microsoft.com/dotnet/api/system.linq.enumerable.zip
 func Zip[First, Second, Result any](first iter.Seq[First], second iter.Seq[Second],
 	resultSelector func(First, Second) Result) (iter.Seq[Result], error) {
-	if first == nil || second == nil {
-		return nil, ErrNilSource
+	if first == nil {
+		return nil, newOperatorError("Zip", "first", ErrNilSource)
+	}
+	if second == nil {
+		return nil, newOperatorError("Zip", "second", ErrNilSource)
 	}
 	if resultSelector == nil {
-		return nil, ErrNilSelector
+		return nil, newOperatorError("Zip", "resultSelector", ErrNilSelector)
 	}
 	return func(yield func(Result) bool) {
 			next1, stop1 := iter.Pull(first)